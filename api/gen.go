@@ -4,359 +4,6479 @@
 package api
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AuditAction.
+const (
+	AuditActionCLUSTERIMPORTED   AuditAction = "CLUSTER_IMPORTED"
+	AuditActionCLUSTERREGISTERED AuditAction = "CLUSTER_REGISTERED"
+	AuditActionCLUSTERUPDATED    AuditAction = "CLUSTER_UPDATED"
+	AuditActionCOMMANDRUN        AuditAction = "COMMAND_RUN"
+	AuditActionHOSTSREPLACED     AuditAction = "HOSTS_REPLACED"
+	AuditActionHOSTSROLLEDBACK   AuditAction = "HOSTS_ROLLED_BACK"
+	AuditActionHOSTSUPDATED      AuditAction = "HOSTS_UPDATED"
 )
 
 // Defines values for ClusterStatus.
 const (
-	HEALTHERR  ClusterStatus = "HEALTH_ERR"
-	HEALTHOK   ClusterStatus = "HEALTH_OK"
-	HEALTHWARN ClusterStatus = "HEALTH_WARN"
+	ClusterStatusHEALTHERR     ClusterStatus = "HEALTH_ERR"
+	ClusterStatusHEALTHOK      ClusterStatus = "HEALTH_OK"
+	ClusterStatusHEALTHUNKNOWN ClusterStatus = "HEALTH_UNKNOWN"
+	ClusterStatusHEALTHWARN    ClusterStatus = "HEALTH_WARN"
+	ClusterStatusPENDING       ClusterStatus = "PENDING"
+)
+
+// Defines values for ErrorCode.
+const (
+	CLUSTERALREADYEXISTS    ErrorCode = "CLUSTER_ALREADY_EXISTS"
+	CLUSTERAUTHFAILED       ErrorCode = "CLUSTER_AUTH_FAILED"
+	CLUSTERIDENTITYMISMATCH ErrorCode = "CLUSTER_IDENTITY_MISMATCH"
+	CLUSTERNOTFOUND         ErrorCode = "CLUSTER_NOT_FOUND"
+	CLUSTERUNREACHABLE      ErrorCode = "CLUSTER_UNREACHABLE"
+	COMMANDFAILED           ErrorCode = "COMMAND_FAILED"
+	COMMANDNOTALLOWED       ErrorCode = "COMMAND_NOT_ALLOWED"
+	HOSTSREJECTED           ErrorCode = "HOSTS_REJECTED"
+	IMPORTCONFLICT          ErrorCode = "IMPORT_CONFLICT"
+	INTERNAL                ErrorCode = "INTERNAL"
+	INVALIDPARAMETER        ErrorCode = "INVALID_PARAMETER"
+	INVALIDREQUEST          ErrorCode = "INVALID_REQUEST"
+	JOBNOTFOUND             ErrorCode = "JOB_NOT_FOUND"
+	OVERVIEWNOTFOUND        ErrorCode = "OVERVIEW_NOT_FOUND"
+	UNSUPPORTEDVERSION      ErrorCode = "UNSUPPORTED_VERSION"
+)
+
+// Defines values for EventKind.
+const (
+	EventKindHOSTSCHANGED        EventKind = "HOSTS_CHANGED"
+	EventKindHOSTSREJECTED       EventKind = "HOSTS_REJECTED"
+	EventKindHOSTSROLLEDBACK     EventKind = "HOSTS_ROLLED_BACK"
+	EventKindIDENTITYMISMATCH    EventKind = "IDENTITY_MISMATCH"
+	EventKindMONITORJOINEDQUORUM EventKind = "MONITOR_JOINED_QUORUM"
+	EventKindMONITORLEFTQUORUM   EventKind = "MONITOR_LEFT_QUORUM"
+	EventKindMONMAPEPOCHCHANGED  EventKind = "MONMAP_EPOCH_CHANGED"
+	EventKindRELEASECHANGED      EventKind = "RELEASE_CHANGED"
+)
+
+// Defines values for ForecastState.
+const (
+	NOTPREDICTED ForecastState = "NOT_PREDICTED"
+	PREDICTED    ForecastState = "PREDICTED"
+	REACHED      ForecastState = "REACHED"
+)
+
+// Defines values for ImportResultAction.
+const (
+	CREATED   ImportResultAction = "CREATED"
+	FAILED    ImportResultAction = "FAILED"
+	SKIPPED   ImportResultAction = "SKIPPED"
+	UNCHANGED ImportResultAction = "UNCHANGED"
+	UPDATED   ImportResultAction = "UPDATED"
+)
+
+// Defines values for JobKind.
+const (
+	REGISTERCLUSTER JobKind = "REGISTER_CLUSTER"
+)
+
+// Defines values for JobState.
+const (
+	JobStateFAILED    JobState = "FAILED"
+	JobStatePENDING   JobState = "PENDING"
+	JobStateRUNNING   JobState = "RUNNING"
+	JobStateSUCCEEDED JobState = "SUCCEEDED"
+)
+
+// Defines values for KeyMode.
+const (
+	Encrypt KeyMode = "encrypt"
+	Omit    KeyMode = "omit"
+	Plain   KeyMode = "plain"
+)
+
+// Defines values for ReachabilityState.
+const (
+	AUTHFAILURE      ReachabilityState = "AUTH_FAILURE"
+	IDENTITYMISMATCH ReachabilityState = "IDENTITY_MISMATCH"
+	QUORUMLOST       ReachabilityState = "QUORUM_LOST"
+	REACHABLE        ReachabilityState = "REACHABLE"
+	RUNTIMEERROR     ReachabilityState = "RUNTIME_ERROR"
+	TIMEOUT          ReachabilityState = "TIMEOUT"
+	UNKNOWN          ReachabilityState = "UNKNOWN"
+	UNREACHABLE      ReachabilityState = "UNREACHABLE"
+)
+
+// Defines values for GetAvailabilityParamsFormat.
+const (
+	Csv  GetAvailabilityParamsFormat = "csv"
+	Json GetAvailabilityParamsFormat = "json"
+)
+
+// Defines values for ListClustersParamsSort.
+const (
+	Id               ListClustersParamsSort = "id"
+	LastContact      ListClustersParamsSort = "last_contact"
+	MinusId          ListClustersParamsSort = "-id"
+	MinusLastContact ListClustersParamsSort = "-last_contact"
+	MinusName        ListClustersParamsSort = "-name"
+	MinusStatus      ListClustersParamsSort = "-status"
+	Name             ListClustersParamsSort = "name"
+	Status           ListClustersParamsSort = "status"
+)
+
+// Defines values for ImportClustersParamsOnConflict.
+const (
+	Fail      ImportClustersParamsOnConflict = "fail"
+	Overwrite ImportClustersParamsOnConflict = "overwrite"
+	Skip      ImportClustersParamsOnConflict = "skip"
 )
 
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditAction `json:"action"`
+
+	// Actor 요청한 사람. scheduler가 바꾼 경우 scheduler
+	Actor string `json:"actor"`
+
+	// Changes 바뀐 설정 항목. key 값은 남기지 않는다
+	Changes   []FieldChange `json:"changes"`
+	ClusterId string        `json:"cluster_id"`
+	Detail    string        `json:"detail"`
+	Id        string        `json:"id"`
+	Time      time.Time     `json:"time"`
+}
+
+// Capacity defines model for Capacity.
+type Capacity struct {
+	AvailBytes int64 `json:"avail_bytes"`
+	TotalBytes int64 `json:"total_bytes"`
+
+	// UsedBytes raw 사용량
+	UsedBytes int64 `json:"used_bytes"`
+}
+
+// ClientIO defines model for ClientIO.
+type ClientIO struct {
+	ReadBytesPerSec  int64 `json:"read_bytes_per_sec"`
+	ReadOpsPerSec    int64 `json:"read_ops_per_sec"`
+	WriteBytesPerSec int64 `json:"write_bytes_per_sec"`
+	WriteOpsPerSec   int64 `json:"write_ops_per_sec"`
+}
+
+// ClientPoolStats defines model for ClientPoolStats.
+type ClientPoolStats struct {
+	// Evictions 오래 쓰이지 않아 session을 버린 횟수
+	Evictions int64 `json:"evictions"`
+
+	// Hits 기존 session을 재사용한 횟수
+	Hits int64 `json:"hits"`
+
+	// Invalidations hosts나 key가 바뀌어 session을 버린 횟수
+	Invalidations int64 `json:"invalidations"`
+
+	// Misses session을 새로 만든 횟수
+	Misses int64 `json:"misses"`
+
+	// Sessions 현재 유지 중인 session 수
+	Sessions int `json:"sessions"`
+}
+
 // Cluster defines model for Cluster.
 type Cluster struct {
 	// Detail cluster 상태가 좋지 않을때, 해당 이유에 대해 보여준다.
 	Detail interface{} `json:"detail,omitempty"`
-	Id     string      `json:"id"`
+
+	// Entity 접속할 때 쓰는 ceph 사용자. 예 client.admin
+	Entity string `json:"entity"`
+
+	// FallbackHosts hosts를 바꾸기 전에 사용하던 hosts. 새 hosts로 접근하지 못하면 이것으로 되돌린다.
+	FallbackHosts *[]string `json:"fallback_hosts,omitempty"`
+
+	// Fsid ceph cluster의 fsid. 아직 확인하지 못했으면 없다.
+	Fsid  *string  `json:"fsid,omitempty"`
+	Hosts []string `json:"hosts"`
+
+	// HostsPinned true이면 monmap에 따라 hosts를 자동으로 바꾸지 않는다.
+	HostsPinned bool   `json:"hosts_pinned"`
+	Id          string `json:"id"`
 
 	// IsStable 일정 시간 이상 HEALTH_OK가 유지되는 상태
-	IsStable bool          `json:"is_stable"`
-	Name     string        `json:"name"`
+	IsStable bool `json:"is_stable"`
+
+	// Labels cluster를 분류하는 key/value. key는 "team"이나 "example.com/team" 형태이고,
+	// 이름과 value는 63자 이하의 영문자, 숫자, "-", "_", "."이며 영문자나 숫자로 시작하고 끝난다.
+	Labels *Labels `json:"labels,omitempty"`
+
+	// MonitorMap 마지막으로 확인한 monmap. 아직 확인하지 못했으면 없다.
+	MonitorMap *MonitorMap `json:"monitor_map,omitempty"`
+
+	// MonitorProbes cepher가 monitor 주소마다 직접 접속해 본 결과.
+	// 접속 여부가 그대로면 latency_ms, last_error와 probed_at은 접속 여부가 마지막으로 바뀐 refresh의 값이다.
+	MonitorProbes *[]MonitorProbe `json:"monitor_probes,omitempty"`
+	Name          string          `json:"name"`
+
+	// Reachability cepher가 cluster에 접근할 수 있는지를 보여준다. cluster의 health와는 별개이다.
+	Reachability Reachability `json:"reachability"`
+
+	// Status PENDING은 비동기 등록을 요청했지만 아직 확인하지 못한 cluster이다.
 	Status   ClusterStatus `json:"status"`
+	Versions *Versions     `json:"versions,omitempty"`
+}
+
+// ClusterAvailability defines model for ClusterAvailability.
+type ClusterAvailability struct {
+	ClusterId string `json:"cluster_id"`
+
+	// Incidents 기간 중 HEALTH_OK에서 다른 status로 바뀐 횟수
+	Incidents int `json:"incidents"`
+
+	// MttrSeconds 끝난 장애의 평균 지속 시간. 끝난 장애가 없으면 없다.
+	MttrSeconds *float64 `json:"mttr_seconds,omitempty"`
+	Name        string   `json:"name"`
+
+	// RecordedSeconds 기간 중 status를 알고 있는 시간. 등록 전이나 기록을 시작하기 전은 빠진다.
+	RecordedSeconds float64 `json:"recorded_seconds"`
+
+	// ResolvedIncidents 기간 중 시작해서 until 전에 다시 HEALTH_OK가 된 장애의 수
+	ResolvedIncidents int       `json:"resolved_incidents"`
+	Since             time.Time `json:"since"`
+
+	// States status마다 머문 시간. PENDING까지 모두 더하면 recorded_seconds이다.
+	States []TimeInState `json:"states"`
+	Until  time.Time     `json:"until"`
 }
 
-// ClusterStatus defines model for Cluster.Status.
+// ClusterStatus PENDING은 비동기 등록을 요청했지만 아직 확인하지 못한 cluster이다.
 type ClusterStatus string
 
+// CommandResult defines model for CommandResult.
+type CommandResult struct {
+	Command    string  `json:"command"`
+	DurationMs float64 `json:"duration_ms"`
+
+	// Output ceph 커맨드의 json 출력
+	Output interface{} `json:"output"`
+}
+
+// CommandSpec defines model for CommandSpec.
+type CommandSpec struct {
+	// MaxArgs prefix 뒤에 더 붙일 수 있는 인자 수
+	MaxArgs        int     `json:"max_args"`
+	Prefix         string  `json:"prefix"`
+	TimeoutSeconds float64 `json:"timeout_seconds"`
+}
+
+// DaemonVersion defines model for DaemonVersion.
+type DaemonVersion struct {
+	Count int `json:"count"`
+
+	// Daemon daemon 종류(mon, mgr, osd, mds, rgw 등)
+	Daemon  string `json:"daemon"`
+	Release string `json:"release"`
+	Version string `json:"version"`
+}
+
 // Error defines model for Error.
 type Error struct {
-	Message string `json:"message"`
+	// Code 오류의 종류이다. 400은 요청을, 404와 409는 등록된 cluster와의 관계를, 502는 cluster 접근 실패를, 500은 container runtime 오류를 포함한 cepher 내부 오류를 뜻한다.
+	Code ErrorCode `json:"code"`
+
+	// Field 잘못된 입력 항목이다. 예: name, hosts[0]. code가 INVALID_PARAMETER일 때만 있다.
+	Field   *string `json:"field,omitempty"`
+	Message string  `json:"message"`
 }
 
-// RegisterCluster defines model for RegisterCluster.
-type RegisterCluster struct {
-	Hosts []string `json:"hosts"`
-	Key   string   `json:"key"`
-	Name  string   `json:"name"`
+// ErrorCode 오류의 종류이다. 400은 요청을, 404와 409는 등록된 cluster와의 관계를, 502는 cluster 접근 실패를, 500은 container runtime 오류를 포함한 cepher 내부 오류를 뜻한다.
+type ErrorCode string
+
+// Event defines model for Event.
+type Event struct {
+	Id      string    `json:"id"`
+	Kind    EventKind `json:"kind"`
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
 }
 
-// RegisterClusterJSONRequestBody defines body for RegisterCluster for application/json ContentType.
-type RegisterClusterJSONRequestBody = RegisterCluster
+// EventKind defines model for Event.Kind.
+type EventKind string
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+// ExportDocument defines model for ExportDocument.
+type ExportDocument struct {
+	Clusters []ExportedCluster `json:"clusters"`
 
-	// (GET /clusters)
-	ListClusters(w http.ResponseWriter, r *http.Request)
+	// Encryption key_mode가 encrypt일 때 key를 복호화하는 데 필요한 값
+	Encryption *KeyEncryption `json:"encryption,omitempty"`
+	ExportedAt time.Time      `json:"exported_at"`
+	KeyMode    KeyMode        `json:"key_mode"`
 
-	// (POST /clusters)
-	RegisterCluster(w http.ResponseWriter, r *http.Request)
+	// Version 문서 형식의 버전. 현재 1이다.
+	Version int `json:"version"`
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// ExportedCluster defines model for ExportedCluster.
+type ExportedCluster struct {
+	// Entity 없으면 client.admin이다.
+	Entity        *string   `json:"entity,omitempty"`
+	FallbackHosts *[]string `json:"fallback_hosts,omitempty"`
+	Fsid          *string   `json:"fsid,omitempty"`
+	Hosts         []string  `json:"hosts"`
+	HostsPinned   *bool     `json:"hosts_pinned,omitempty"`
 
-type Unimplemented struct{}
+	// Id 가져올 때 비어 있지 않으면 이 id를 그대로 쓴다.
+	Id *string `json:"id,omitempty"`
 
-// (GET /clusters)
-func (_ Unimplemented) ListClusters(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Key key_mode가 omit이면 없고, encrypt이면 base64로 인코딩한 nonce와 암호문이다.
+	Key *string `json:"key,omitempty"`
 
-// (POST /clusters)
-func (_ Unimplemented) RegisterCluster(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
+	// Labels 가져올 때 없으면 이미 등록된 cluster의 label을 그대로 둔다.
+	Labels *Labels `json:"labels,omitempty"`
+	Name   string  `json:"name"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	After  string `json:"after"`
+	Before string `json:"before"`
+	Field  string `json:"field"`
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// FleetSummary defines model for FleetSummary.
+type FleetSummary struct {
+	GeneratedAt time.Time `json:"generated_at"`
 
-// ListClusters operation middleware
-func (siw *ServerInterfaceWrapper) ListClusters(w http.ResponseWriter, r *http.Request) {
+	// Groups group_by를 지정했을 때 label value마다의 현황. label이 없는 cluster의 group이 마지막이다.
+	Groups *[]SummaryGroup `json:"groups,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListClusters(w, r)
-	}))
+	// Reachabilities reachability state마다의 cluster 수
+	Reachabilities map[string]int `json:"reachabilities"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Stable 일정 시간 이상 HEALTH_OK가 유지되는 cluster 수
+	Stable int `json:"stable"`
 
-	handler.ServeHTTP(w, r)
+	// Statuses cluster status마다의 cluster 수. cluster가 없는 status도 0으로 들어 있다.
+	Statuses StatusCounts `json:"statuses"`
+
+	// TopChecks 가장 많은 cluster에서 발생한 health check부터의 목록
+	TopChecks []HealthCheckCount `json:"top_checks"`
+	Total     int                `json:"total"`
 }
 
-// RegisterCluster operation middleware
-func (siw *ServerInterfaceWrapper) RegisterCluster(w http.ResponseWriter, r *http.Request) {
+// Forecast 사용률이 threshold를 넘는 시점을 선형 회귀로 예측한 결과
+type Forecast struct {
+	State     ForecastState `json:"state"`
+	Threshold float64       `json:"threshold"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RegisterCluster(w, r)
-	}))
+	// Time state가 PREDICTED일 때 예측 시점
+	Time *time.Time `json:"time,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// ForecastState defines model for Forecast.State.
+type ForecastState string
 
-	handler.ServeHTTP(w, r)
-}
+// HealthCheckCount defines model for HealthCheckCount.
+type HealthCheckCount struct {
+	ClusterIds []string `json:"cluster_ids"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	// Code ceph health check code. 예 OSD_DOWN
+	Code  string `json:"code"`
+	Count int    `json:"count"`
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+	// Severity cluster마다 다르면 가장 심각한 것이다.
+	Severity string `json:"severity"`
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	DryRun  bool           `json:"dry_run"`
+	Results []ImportResult `json:"results"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	Action ImportResultAction `json:"action"`
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+	// ClusterId 만들었거나 충돌한 cluster의 id
+	ClusterId *string `json:"cluster_id,omitempty"`
+	Fsid      *string `json:"fsid,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	Name      string  `json:"name"`
 }
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+// ImportResultAction defines model for ImportResult.Action.
+type ImportResultAction string
 
-type RequiredParamError struct {
-	ParamName string
-}
+// Job defines model for Job.
+type Job struct {
+	ClusterId string    `json:"cluster_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        string    `json:"id"`
+	Kind      JobKind   `json:"kind"`
+	State     JobState  `json:"state"`
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+	// Steps 시작한 순서대로의 단계. 등록 job은 CONNECTIVITY, AUTHENTICATION, HEALTH, REGISTRATION 순으로 진행하고 실패한 단계에서 멈춘다.
+	Steps     []JobStep `json:"steps"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+// JobKind defines model for Job.Kind.
+type JobKind string
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+// JobState defines model for JobState.
+type JobState string
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+// JobStep defines model for JobStep.
+type JobStep struct {
+	// FinishedAt 진행 중인 단계에는 없다.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Message 단계의 결과. 실패한 단계에서는 실패 이유이다.
+	Message   *string   `json:"message,omitempty"`
+	Name      string    `json:"name"`
+	StartedAt time.Time `json:"started_at"`
+	State     JobState  `json:"state"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+// KeyEncryption key_mode가 encrypt일 때 key를 복호화하는 데 필요한 값
+type KeyEncryption struct {
+	// Algorithm AES-256-GCM
+	Algorithm  string `json:"algorithm"`
+	Iterations int    `json:"iterations"`
+
+	// Kdf PBKDF2-SHA256
+	Kdf string `json:"kdf"`
+
+	// Salt base64로 인코딩한 salt
+	Salt string `json:"salt"`
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// KeyMode defines model for KeyMode.
+type KeyMode string
+
+// Labels cluster를 분류하는 key/value. key는 "team"이나 "example.com/team" 형태이고,
+// 이름과 value는 63자 이하의 영문자, 숫자, "-", "_", "."이며 영문자나 숫자로 시작하고 끝난다.
+type Labels map[string]string
+
+// Monitor defines model for Monitor.
+type Monitor struct {
+	Addrs    []MonitorAddress `json:"addrs"`
+	InQuorum bool             `json:"in_quorum"`
+	Name     string           `json:"name"`
+	Rank     int              `json:"rank"`
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// MonitorAddress defines model for MonitorAddress.
+type MonitorAddress struct {
+	Addr string `json:"addr"`
+
+	// Type messenger 버전 (v1, v2)
+	Type string `json:"type"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+// MonitorMap 마지막으로 확인한 monmap. 아직 확인하지 못했으면 없다.
+type MonitorMap struct {
+	Epoch    int       `json:"epoch"`
+	Monitors []Monitor `json:"monitors"`
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+// MonitorProbe defines model for MonitorProbe.
+type MonitorProbe struct {
+	Address   string    `json:"address"`
+	LastError *string   `json:"last_error,omitempty"`
+	LatencyMs float64   `json:"latency_ms"`
+	ProbedAt  time.Time `json:"probed_at"`
+
+	// Reachable TCP로 접속해 ceph messenger banner를 받았는지 여부
+	Reachable bool `json:"reachable"`
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
+// OSD defines model for OSD.
+type OSD struct {
+	CrushWeight float64 `json:"crush_weight"`
+	DeviceClass string  `json:"device_class"`
+
+	// Host crush map 상에서 OSD가 속한 host. 속한 host가 없으면 빈 문자열이다.
+	Host     string  `json:"host"`
+	Id       int     `json:"id"`
+	In       bool    `json:"in"`
+	Name     string  `json:"name"`
+	Pgs      int     `json:"pgs"`
+	Reweight float64 `json:"reweight"`
+	Up       bool    `json:"up"`
+
+	// Utilization 사용률(%)
+	Utilization float64 `json:"utilization"`
 }
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+// OSDCount defines model for OSDCount.
+type OSDCount struct {
+	In    int `json:"in"`
+	Total int `json:"total"`
+	Up    int `json:"up"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// Overview 마지막 refresh 시점의 cluster 상태 요약
+type Overview struct {
+	Capacity    Capacity  `json:"capacity"`
+	ClientIo    ClientIO  `json:"client_io"`
+	CollectedAt time.Time `json:"collected_at"`
+	Osds        OSDCount  `json:"osds"`
+	Pgs         PGCount   `json:"pgs"`
+	PoolCount   int       `json:"pool_count"`
+
+	// Quorum quorum에 참여하고 있는 monitor 이름
+	Quorum []string `json:"quorum"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// PGCount defines model for PGCount.
+type PGCount struct {
+	// States PG 상태별 개수 (예. active+clean)
+	States map[string]int `json:"states"`
+	Total  int            `json:"total"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// Reachability cepher가 cluster에 접근할 수 있는지를 보여준다. cluster의 health와는 별개이다.
+type Reachability struct {
+	// LastContactTime 마지막으로 접근에 성공한 시각
+	LastContactTime *time.Time `json:"last_contact_time,omitempty"`
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// LastError 마지막으로 접근에 실패한 이유
+	LastError *string           `json:"last_error,omitempty"`
+	State     ReachabilityState `json:"state"`
+}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/clusters", wrapper.ListClusters)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/clusters", wrapper.RegisterCluster)
-	})
+// ReachabilityState defines model for Reachability.State.
+type ReachabilityState string
 
-	return r
-}
+// RegisterCluster defines model for RegisterCluster.
+type RegisterCluster struct {
+	// Entity 접속할 때 쓰는 ceph 사용자. 기본값은 client.admin이다.
+	Entity *string `json:"entity,omitempty"`
 
-type ListClustersRequestObject struct {
-}
+	// Hosts monitor 주소. "10.0.0.1:6789", "[2001:db8::1]:6789", "mon1.example.com:6789" 형태이며,
+	// "v2:10.0.0.1:3300", "v1:10.0.0.1:6789"처럼 messenger 버전을 지정할 수 있다.
+	// DNS 이름은 접속할 때마다 해석한다.
+	Hosts []string `json:"hosts"`
+	Key   string   `json:"key"`
 
-type ListClustersResponseObject interface {
-	VisitListClustersResponse(w http.ResponseWriter) error
+	// Labels cluster를 분류하는 key/value. key는 "team"이나 "example.com/team" 형태이고,
+	// 이름과 value는 63자 이하의 영문자, 숫자, "-", "_", "."이며 영문자나 숫자로 시작하고 끝난다.
+	Labels *Labels `json:"labels,omitempty"`
+	Name   string  `json:"name"`
 }
 
-type ListClusters200JSONResponse []Cluster
+// RegisterClusterFromConfig defines model for RegisterClusterFromConfig.
+type RegisterClusterFromConfig struct {
+	// CephConf ceph.conf의 내용. [global]의 mon_host(v1/v2 addrvec 포함)와 fsid를 읽는다.
+	CephConf string `json:"ceph_conf"`
 
-func (response ListClusters200JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Entity keyring에서 쓸 사용자. 없으면 client.admin을 쓰고, client.admin이 없으면 keyring의 유일한 client를 쓴다.
+	Entity *string `json:"entity,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Keyring keyring 파일의 내용
+	Keyring string `json:"keyring"`
+
+	// Labels cluster를 분류하는 key/value. key는 "team"이나 "example.com/team" 형태이고,
+	// 이름과 value는 63자 이하의 영문자, 숫자, "-", "_", "."이며 영문자나 숫자로 시작하고 끝난다.
+	Labels *Labels `json:"labels,omitempty"`
+	Name   string  `json:"name"`
 }
 
-type ListClusters204Response struct {
+// RunCommand defines model for RunCommand.
+type RunCommand struct {
+	// Args ceph 뒤에 붙일 인자. 예 ["osd", "pool", "ls", "detail"]. -f json은 자동으로 붙는다
+	Args []string `json:"args"`
 }
 
-func (response ListClusters204Response) VisitListClustersResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// StatusCounts cluster status마다의 cluster 수. cluster가 없는 status도 0으로 들어 있다.
+type StatusCounts map[string]int
+
+// SummaryGroup defines model for SummaryGroup.
+type SummaryGroup struct {
+	Stable int `json:"stable"`
+
+	// Statuses cluster status마다의 cluster 수. cluster가 없는 status도 0으로 들어 있다.
+	Statuses StatusCounts `json:"statuses"`
+	Total    int          `json:"total"`
+
+	// Value label의 value. label이 없는 cluster의 group이면 없다.
+	Value *string `json:"value,omitempty"`
 }
 
-type ListClusters500JSONResponse Error
+// TimeInState defines model for TimeInState.
+type TimeInState struct {
+	// Percent status를 알고 있는 시간 중의 비율 (0~100)
+	Percent float64 `json:"percent"`
+	Seconds float64 `json:"seconds"`
 
-func (response ListClusters500JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Status PENDING은 비동기 등록을 요청했지만 아직 확인하지 못한 cluster이다.
+	Status ClusterStatus `json:"status"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// UpdateCluster defines model for UpdateCluster.
+type UpdateCluster struct {
+	// Labels 있으면 label을 모두 이 값으로 바꾼다. 빈 object이면 label을 모두 지운다.
+	Labels *Labels `json:"labels,omitempty"`
 }
 
-type RegisterClusterRequestObject struct {
-	Body *RegisterClusterJSONRequestBody
+// UpdateHosts defines model for UpdateHosts.
+type UpdateHosts struct {
+	// Hosts 비어 있으면 hosts는 그대로 두고 pinned만 반영한다.
+	Hosts  *[]string `json:"hosts,omitempty"`
+	Pinned bool      `json:"pinned"`
 }
 
-type RegisterClusterResponseObject interface {
-	VisitRegisterClusterResponse(w http.ResponseWriter) error
+// UsagePoint defines model for UsagePoint.
+type UsagePoint struct {
+	Time      time.Time `json:"time"`
+	UsedBytes int64     `json:"used_bytes"`
+	UsedRatio float64   `json:"used_ratio"`
 }
 
-type RegisterCluster201JSONResponse Cluster
+// UsageSeries defines model for UsageSeries.
+type UsageSeries struct {
+	// Full 사용률이 threshold를 넘는 시점을 선형 회귀로 예측한 결과
+	Full    Forecast     `json:"full"`
+	History []UsagePoint `json:"history"`
 
-func (response RegisterCluster201JSONResponse) VisitRegisterClusterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// Name pool 이름. cluster 전체일 때는 비어 있다.
+	Name *string `json:"name,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Nearfull 사용률이 threshold를 넘는 시점을 선형 회귀로 예측한 결과
+	Nearfull Forecast `json:"nearfull"`
 }
 
-type RegisterCluster400Response struct {
+// UsageTrend defines model for UsageTrend.
+type UsageTrend struct {
+	Cluster       UsageSeries   `json:"cluster"`
+	FullRatio     float64       `json:"full_ratio"`
+	NearfullRatio float64       `json:"nearfull_ratio"`
+	Pools         []UsageSeries `json:"pools"`
 }
 
-func (response RegisterCluster400Response) VisitRegisterClusterResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+// Versions defines model for Versions.
+type Versions struct {
+	Daemons []DaemonVersion `json:"daemons"`
+
+	// Mixed daemon들이 서로 다른 버전으로 동작하는지 여부. upgrade 중이면 true
+	Mixed bool `json:"mixed"`
+
+	// Release cluster의 ceph release 이름(예 reef, squid). 확인하지 못했으면 빈 문자열
+	Release string `json:"release"`
 }
 
-type RegisterCluster500JSONResponse Error
+// Actor defines model for Actor.
+type Actor = string
+
+// Async defines model for Async.
+type Async = bool
+
+// ClusterID defines model for ClusterID.
+type ClusterID = string
+
+// Passphrase defines model for Passphrase.
+type Passphrase = string
+
+// ListAuditEntriesParams defines parameters for ListAuditEntries.
+type ListAuditEntriesParams struct {
+	ClusterId *string      `form:"cluster_id,omitempty" json:"cluster_id,omitempty"`
+	Actor     *string      `form:"actor,omitempty" json:"actor,omitempty"`
+	Action    *AuditAction `form:"action,omitempty" json:"action,omitempty"`
+
+	// Since 이 시각 이후의 entry만 반환한다.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until 이 시각 이전의 entry만 반환한다.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit 반환할 최대 개수. 기본값은 100이다.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetAvailabilityParams defines parameters for GetAvailability.
+type GetAvailabilityParams struct {
+	// ClusterId 없으면 모든 cluster를 계산한다.
+	ClusterId *string `form:"cluster_id,omitempty" json:"cluster_id,omitempty"`
+
+	// Since 기간의 시작. 기본값은 until의 30일 전이다.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until 기간의 끝. 기본값은 현재 시각이고, 현재 시각보다 뒤이면 현재 시각까지 계산한다.
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Format json(기본값) 또는 csv
+	Format *GetAvailabilityParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAvailabilityParamsFormat defines parameters for GetAvailability.
+type GetAvailabilityParamsFormat string
+
+// ListClustersParams defines parameters for ListClusters.
+type ListClustersParams struct {
+	// Selector label 조건. "env=prod,region!=us-east,team,!deprecated,tier in (web,db),zone notin (a)" 형태이며
+	// 모든 조건을 만족하는 cluster만 반환한다.
+	Selector *string `form:"selector,omitempty" json:"selector,omitempty"`
+
+	// Status 이 중 하나의 status인 cluster만 반환한다.
+	Status *[]ClusterStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Name 이름에 이 문자열이 들어 있는 cluster만 반환한다. 대소문자를 구분하지 않는다.
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Sort 정렬 기준. 앞에 "-"를 붙이면 역순이다. 기본값은 name이다.
+	Sort   *ListClustersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Offset *int                    `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit 반환할 최대 개수. 없으면 모두 반환한다.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListClustersParamsSort defines parameters for ListClusters.
+type ListClustersParamsSort string
+
+// RegisterClusterParams defines parameters for RegisterCluster.
+type RegisterClusterParams struct {
+	// Async true이면 cluster를 PENDING 상태로 저장하고 바로 202와 job을 반환한다.
+	// 접속, 인증, health 확인은 background에서 진행하며 GET /jobs/{id}로 확인한다.
+	Async *Async `form:"async,omitempty" json:"async,omitempty"`
+
+	// XCepherActor 요청한 사람. audit log에 남긴다
+	XCepherActor *Actor `json:"X-Cepher-Actor,omitempty"`
+}
+
+// RegisterClusterFromConfigMultipartBody defines parameters for RegisterClusterFromConfig.
+type RegisterClusterFromConfigMultipartBody struct {
+	CephConf openapi_types.File `json:"ceph_conf"`
+	Entity   *string            `json:"entity,omitempty"`
+	Keyring  openapi_types.File `json:"keyring"`
+
+	// Labels cluster를 분류하는 key/value. key는 "team"이나 "example.com/team" 형태이고,
+	// 이름과 value는 63자 이하의 영문자, 숫자, "-", "_", "."이며 영문자나 숫자로 시작하고 끝난다.
+	Labels *Labels `json:"labels,omitempty"`
+	Name   string  `json:"name"`
+}
+
+// RegisterClusterFromConfigParams defines parameters for RegisterClusterFromConfig.
+type RegisterClusterFromConfigParams struct {
+	// Async true이면 cluster를 PENDING 상태로 저장하고 바로 202와 job을 반환한다.
+	// 접속, 인증, health 확인은 background에서 진행하며 GET /jobs/{id}로 확인한다.
+	Async *Async `form:"async,omitempty" json:"async,omitempty"`
+
+	// XCepherActor 요청한 사람. audit log에 남긴다
+	XCepherActor *Actor `json:"X-Cepher-Actor,omitempty"`
+}
+
+// UpdateClusterParams defines parameters for UpdateCluster.
+type UpdateClusterParams struct {
+	// XCepherActor 요청한 사람. audit log에 남긴다
+	XCepherActor *Actor `json:"X-Cepher-Actor,omitempty"`
+}
+
+// RunClusterCommandParams defines parameters for RunClusterCommand.
+type RunClusterCommandParams struct {
+	// XCepherActor 요청한 사람. audit log에 남긴다
+	XCepherActor *Actor `json:"X-Cepher-Actor,omitempty"`
+}
+
+// UpdateClusterHostsParams defines parameters for UpdateClusterHosts.
+type UpdateClusterHostsParams struct {
+	// XCepherActor 요청한 사람. audit log에 남긴다
+	XCepherActor *Actor `json:"X-Cepher-Actor,omitempty"`
+}
+
+// GetClusterUsageParams defines parameters for GetClusterUsage.
+type GetClusterUsageParams struct {
+	// Since 이 시각 이후의 사용량만 사용한다. 기본값은 30일 전이다.
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+}
+
+// ExportClustersParams defines parameters for ExportClusters.
+type ExportClustersParams struct {
+	// KeyMode omit(기본값)이면 key를 빼고, encrypt이면 passphrase로 암호화해서 담는다.
+	// key를 그대로 담으려면 plain을 명시해야 한다.
+	KeyMode *KeyMode `form:"key_mode,omitempty" json:"key_mode,omitempty"`
+
+	// XCepherPassphrase key를 암호화하거나 복호화할 passphrase. URL이나 log에 남지 않도록 header로 받는다
+	XCepherPassphrase *Passphrase `json:"X-Cepher-Passphrase,omitempty"`
+}
+
+// ImportClustersParams defines parameters for ImportClusters.
+type ImportClustersParams struct {
+	// DryRun true이면 결과만 계산하고 저장하지 않는다.
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// OnConflict skip(기본값)은 기존 cluster를 그대로 두고, overwrite는 기존 cluster의 설정을 문서의 값으로 바꾸며,
+	// fail은 충돌이 하나라도 있으면 아무것도 가져오지 않는다.
+	OnConflict *ImportClustersParamsOnConflict `form:"on_conflict,omitempty" json:"on_conflict,omitempty"`
+
+	// XCepherActor 요청한 사람. audit log에 남긴다
+	XCepherActor *Actor `json:"X-Cepher-Actor,omitempty"`
+
+	// XCepherPassphrase key를 암호화하거나 복호화할 passphrase. URL이나 log에 남지 않도록 header로 받는다
+	XCepherPassphrase *Passphrase `json:"X-Cepher-Passphrase,omitempty"`
+}
+
+// ImportClustersParamsOnConflict defines parameters for ImportClusters.
+type ImportClustersParamsOnConflict string
+
+// GetSummaryParams defines parameters for GetSummary.
+type GetSummaryParams struct {
+	// GroupBy 이 label의 value마다 cluster를 나눈다.
+	GroupBy *string `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// Top 반환할 health check의 최대 개수. 기본값은 10이다.
+	Top *int `form:"top,omitempty" json:"top,omitempty"`
+}
+
+// RegisterClusterJSONRequestBody defines body for RegisterCluster for application/json ContentType.
+type RegisterClusterJSONRequestBody = RegisterCluster
+
+// RegisterClusterFromConfigJSONRequestBody defines body for RegisterClusterFromConfig for application/json ContentType.
+type RegisterClusterFromConfigJSONRequestBody = RegisterClusterFromConfig
+
+// RegisterClusterFromConfigMultipartRequestBody defines body for RegisterClusterFromConfig for multipart/form-data ContentType.
+type RegisterClusterFromConfigMultipartRequestBody RegisterClusterFromConfigMultipartBody
+
+// UpdateClusterJSONRequestBody defines body for UpdateCluster for application/json ContentType.
+type UpdateClusterJSONRequestBody = UpdateCluster
+
+// RunClusterCommandJSONRequestBody defines body for RunClusterCommand for application/json ContentType.
+type RunClusterCommandJSONRequestBody = RunCommand
+
+// UpdateClusterHostsJSONRequestBody defines body for UpdateClusterHosts for application/json ContentType.
+type UpdateClusterHostsJSONRequestBody = UpdateHosts
+
+// ImportClustersJSONRequestBody defines body for ImportClusters for application/json ContentType.
+type ImportClustersJSONRequestBody = ExportDocument
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// ListAuditEntries request
+	ListAuditEntries(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAvailability request
+	GetAvailability(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClientPool request
+	GetClientPool(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClusters request
+	ListClusters(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterClusterWithBody request with any body
+	RegisterClusterWithBody(ctx context.Context, params *RegisterClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegisterCluster(ctx context.Context, params *RegisterClusterParams, body RegisterClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterClusterFromConfigWithBody request with any body
+	RegisterClusterFromConfigWithBody(ctx context.Context, params *RegisterClusterFromConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RegisterClusterFromConfig(ctx context.Context, params *RegisterClusterFromConfigParams, body RegisterClusterFromConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCluster request
+	GetCluster(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateClusterWithBody request with any body
+	UpdateClusterWithBody(ctx context.Context, id ClusterID, params *UpdateClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCluster(ctx context.Context, id ClusterID, params *UpdateClusterParams, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunClusterCommandWithBody request with any body
+	RunClusterCommandWithBody(ctx context.Context, id ClusterID, params *RunClusterCommandParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RunClusterCommand(ctx context.Context, id ClusterID, params *RunClusterCommandParams, body RunClusterCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClusterEvents request
+	ListClusterEvents(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateClusterHostsWithBody request with any body
+	UpdateClusterHostsWithBody(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateClusterHosts(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, body UpdateClusterHostsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClusterOsds request
+	ListClusterOsds(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClusterOverview request
+	GetClusterOverview(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClusterUsage request
+	GetClusterUsage(ctx context.Context, id ClusterID, params *GetClusterUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCommands request
+	ListCommands(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportClusters request
+	ExportClusters(ctx context.Context, params *ExportClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportClustersWithBody request with any body
+	ImportClustersWithBody(ctx context.Context, params *ImportClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ImportClusters(ctx context.Context, params *ImportClustersParams, body ImportClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJob request
+	GetJob(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSummary request
+	GetSummary(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ListAuditEntries(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAuditEntriesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAvailability(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAvailabilityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClientPool(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClientPoolRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClusters(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClustersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterClusterWithBody(ctx context.Context, params *RegisterClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterClusterRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterCluster(ctx context.Context, params *RegisterClusterParams, body RegisterClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterClusterRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterClusterFromConfigWithBody(ctx context.Context, params *RegisterClusterFromConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterClusterFromConfigRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RegisterClusterFromConfig(ctx context.Context, params *RegisterClusterFromConfigParams, body RegisterClusterFromConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterClusterFromConfigRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCluster(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateClusterWithBody(ctx context.Context, id ClusterID, params *UpdateClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCluster(ctx context.Context, id ClusterID, params *UpdateClusterParams, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunClusterCommandWithBody(ctx context.Context, id ClusterID, params *RunClusterCommandParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunClusterCommandRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RunClusterCommand(ctx context.Context, id ClusterID, params *RunClusterCommandParams, body RunClusterCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunClusterCommandRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClusterEvents(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterEventsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateClusterHostsWithBody(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterHostsRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateClusterHosts(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, body UpdateClusterHostsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterHostsRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListClusterOsds(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterOsdsRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClusterOverview(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterOverviewRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetClusterUsage(ctx context.Context, id ClusterID, params *GetClusterUsageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterUsageRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCommands(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCommandsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportClusters(ctx context.Context, params *ExportClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportClustersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportClustersWithBody(ctx context.Context, params *ImportClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportClustersRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportClusters(ctx context.Context, params *ImportClustersParams, body ImportClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportClustersRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJob(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSummary(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSummaryRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAuditEntriesRequest generates requests for ListAuditEntries
+func NewListAuditEntriesRequest(server string, params *ListAuditEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClusterId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cluster_id", runtime.ParamLocationQuery, *params.ClusterId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAvailabilityRequest generates requests for GetAvailability
+func NewGetAvailabilityRequest(server string, params *GetAvailabilityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/availability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClusterId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cluster_id", runtime.ParamLocationQuery, *params.ClusterId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClientPoolRequest generates requests for GetClientPool
+func NewGetClientPoolRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/client-pool")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClustersRequest generates requests for ListClusters
+func NewListClustersRequest(server string, params *ListClustersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Selector != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "selector", runtime.ParamLocationQuery, *params.Selector); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRegisterClusterRequest calls the generic RegisterCluster builder with application/json body
+func NewRegisterClusterRequest(server string, params *RegisterClusterParams, body RegisterClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterClusterRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewRegisterClusterRequestWithBody generates requests for RegisterCluster with any type of body
+func NewRegisterClusterRequestWithBody(server string, params *RegisterClusterParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Async != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "async", runtime.ParamLocationQuery, *params.Async); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCepherActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Actor", runtime.ParamLocationHeader, *params.XCepherActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Actor", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRegisterClusterFromConfigRequest calls the generic RegisterClusterFromConfig builder with application/json body
+func NewRegisterClusterFromConfigRequest(server string, params *RegisterClusterFromConfigParams, body RegisterClusterFromConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRegisterClusterFromConfigRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewRegisterClusterFromConfigRequestWithBody generates requests for RegisterClusterFromConfig with any type of body
+func NewRegisterClusterFromConfigRequestWithBody(server string, params *RegisterClusterFromConfigParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/from-config")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Async != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "async", runtime.ParamLocationQuery, *params.Async); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCepherActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Actor", runtime.ParamLocationHeader, *params.XCepherActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Actor", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetClusterRequest generates requests for GetCluster
+func NewGetClusterRequest(server string, id ClusterID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateClusterRequest calls the generic UpdateCluster builder with application/json body
+func NewUpdateClusterRequest(server string, id ClusterID, params *UpdateClusterParams, body UpdateClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClusterRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateClusterRequestWithBody generates requests for UpdateCluster with any type of body
+func NewUpdateClusterRequestWithBody(server string, id ClusterID, params *UpdateClusterParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCepherActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Actor", runtime.ParamLocationHeader, *params.XCepherActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Actor", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRunClusterCommandRequest calls the generic RunClusterCommand builder with application/json body
+func NewRunClusterCommandRequest(server string, id ClusterID, params *RunClusterCommandParams, body RunClusterCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRunClusterCommandRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewRunClusterCommandRequestWithBody generates requests for RunClusterCommand with any type of body
+func NewRunClusterCommandRequestWithBody(server string, id ClusterID, params *RunClusterCommandParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/commands", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCepherActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Actor", runtime.ParamLocationHeader, *params.XCepherActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Actor", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListClusterEventsRequest generates requests for ListClusterEvents
+func NewListClusterEventsRequest(server string, id ClusterID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateClusterHostsRequest calls the generic UpdateClusterHosts builder with application/json body
+func NewUpdateClusterHostsRequest(server string, id ClusterID, params *UpdateClusterHostsParams, body UpdateClusterHostsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClusterHostsRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateClusterHostsRequestWithBody generates requests for UpdateClusterHosts with any type of body
+func NewUpdateClusterHostsRequestWithBody(server string, id ClusterID, params *UpdateClusterHostsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/hosts", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCepherActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Actor", runtime.ParamLocationHeader, *params.XCepherActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Actor", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListClusterOsdsRequest generates requests for ListClusterOsds
+func NewListClusterOsdsRequest(server string, id ClusterID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/osds", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClusterOverviewRequest generates requests for GetClusterOverview
+func NewGetClusterOverviewRequest(server string, id ClusterID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/overview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClusterUsageRequest generates requests for GetClusterUsage
+func NewGetClusterUsageRequest(server string, id ClusterID, params *GetClusterUsageParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/usage", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListCommandsRequest generates requests for ListCommands
+func NewListCommandsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/commands")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportClustersRequest generates requests for ExportClusters
+func NewExportClustersRequest(server string, params *ExportClustersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.KeyMode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "key_mode", runtime.ParamLocationQuery, *params.KeyMode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.XCepherPassphrase != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Passphrase", runtime.ParamLocationHeader, *params.XCepherPassphrase)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Passphrase", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewImportClustersRequest calls the generic ImportClusters builder with application/json body
+func NewImportClustersRequest(server string, params *ImportClustersParams, body ImportClustersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportClustersRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewImportClustersRequestWithBody generates requests for ImportClusters with any type of body
+func NewImportClustersRequestWithBody(server string, params *ImportClustersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dry_run", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OnConflict != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "on_conflict", runtime.ParamLocationQuery, *params.OnConflict); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCepherActor != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Actor", runtime.ParamLocationHeader, *params.XCepherActor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Actor", headerParam0)
+		}
+
+		if params.XCepherPassphrase != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Cepher-Passphrase", runtime.ParamLocationHeader, *params.XCepherPassphrase)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-Cepher-Passphrase", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetJobRequest generates requests for GetJob
+func NewGetJobRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSummaryRequest generates requests for GetSummary
+func NewGetSummaryRequest(server string, params *GetSummaryParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/summary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "group_by", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Top != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "top", runtime.ParamLocationQuery, *params.Top); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAuditEntriesWithResponse request
+	ListAuditEntriesWithResponse(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*ListAuditEntriesResponse, error)
+
+	// GetAvailabilityWithResponse request
+	GetAvailabilityWithResponse(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*GetAvailabilityResponse, error)
+
+	// GetClientPoolWithResponse request
+	GetClientPoolWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientPoolResponse, error)
+
+	// ListClustersWithResponse request
+	ListClustersWithResponse(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*ListClustersResponse, error)
+
+	// RegisterClusterWithBodyWithResponse request with any body
+	RegisterClusterWithBodyWithResponse(ctx context.Context, params *RegisterClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterClusterResponse, error)
+
+	RegisterClusterWithResponse(ctx context.Context, params *RegisterClusterParams, body RegisterClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterClusterResponse, error)
+
+	// RegisterClusterFromConfigWithBodyWithResponse request with any body
+	RegisterClusterFromConfigWithBodyWithResponse(ctx context.Context, params *RegisterClusterFromConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterClusterFromConfigResponse, error)
+
+	RegisterClusterFromConfigWithResponse(ctx context.Context, params *RegisterClusterFromConfigParams, body RegisterClusterFromConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterClusterFromConfigResponse, error)
+
+	// GetClusterWithResponse request
+	GetClusterWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*GetClusterResponse, error)
+
+	// UpdateClusterWithBodyWithResponse request with any body
+	UpdateClusterWithBodyWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+
+	UpdateClusterWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterParams, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+
+	// RunClusterCommandWithBodyWithResponse request with any body
+	RunClusterCommandWithBodyWithResponse(ctx context.Context, id ClusterID, params *RunClusterCommandParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunClusterCommandResponse, error)
+
+	RunClusterCommandWithResponse(ctx context.Context, id ClusterID, params *RunClusterCommandParams, body RunClusterCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunClusterCommandResponse, error)
+
+	// ListClusterEventsWithResponse request
+	ListClusterEventsWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*ListClusterEventsResponse, error)
+
+	// UpdateClusterHostsWithBodyWithResponse request with any body
+	UpdateClusterHostsWithBodyWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterHostsResponse, error)
+
+	UpdateClusterHostsWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, body UpdateClusterHostsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterHostsResponse, error)
+
+	// ListClusterOsdsWithResponse request
+	ListClusterOsdsWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*ListClusterOsdsResponse, error)
+
+	// GetClusterOverviewWithResponse request
+	GetClusterOverviewWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*GetClusterOverviewResponse, error)
+
+	// GetClusterUsageWithResponse request
+	GetClusterUsageWithResponse(ctx context.Context, id ClusterID, params *GetClusterUsageParams, reqEditors ...RequestEditorFn) (*GetClusterUsageResponse, error)
+
+	// ListCommandsWithResponse request
+	ListCommandsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCommandsResponse, error)
+
+	// ExportClustersWithResponse request
+	ExportClustersWithResponse(ctx context.Context, params *ExportClustersParams, reqEditors ...RequestEditorFn) (*ExportClustersResponse, error)
+
+	// ImportClustersWithBodyWithResponse request with any body
+	ImportClustersWithBodyWithResponse(ctx context.Context, params *ImportClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportClustersResponse, error)
+
+	ImportClustersWithResponse(ctx context.Context, params *ImportClustersParams, body ImportClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportClustersResponse, error)
+
+	// GetJobWithResponse request
+	GetJobWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetJobResponse, error)
+
+	// GetSummaryWithResponse request
+	GetSummaryWithResponse(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*GetSummaryResponse, error)
+}
+
+type ListAuditEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuditEntry
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAuditEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAuditEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAvailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClusterAvailability
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAvailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAvailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClientPoolResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientPoolStats
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClientPoolResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClientPoolResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Cluster
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Cluster
+	JSON202      *Job
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+	JSON502      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterClusterFromConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Cluster
+	JSON202      *Job
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+	JSON502      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterClusterFromConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterClusterFromConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cluster
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cluster
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunClusterCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CommandResult
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+	JSON502      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r RunClusterCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunClusterCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Event
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClusterHostsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cluster
+	JSON400      *Error
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClusterHostsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClusterHostsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterOsdsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]OSD
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterOsdsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterOsdsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterOverviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Overview
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterOverviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterOverviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterUsageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsageTrend
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterUsageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterUsageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCommandsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CommandSpec
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCommandsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCommandsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ExportDocument
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+	JSON400      *Error
+	JSON409      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+	JSON404      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSummaryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FleetSummary
+	JSON400      *Error
+	JSON500      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSummaryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSummaryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListAuditEntriesWithResponse request returning *ListAuditEntriesResponse
+func (c *ClientWithResponses) ListAuditEntriesWithResponse(ctx context.Context, params *ListAuditEntriesParams, reqEditors ...RequestEditorFn) (*ListAuditEntriesResponse, error) {
+	rsp, err := c.ListAuditEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAuditEntriesResponse(rsp)
+}
+
+// GetAvailabilityWithResponse request returning *GetAvailabilityResponse
+func (c *ClientWithResponses) GetAvailabilityWithResponse(ctx context.Context, params *GetAvailabilityParams, reqEditors ...RequestEditorFn) (*GetAvailabilityResponse, error) {
+	rsp, err := c.GetAvailability(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAvailabilityResponse(rsp)
+}
+
+// GetClientPoolWithResponse request returning *GetClientPoolResponse
+func (c *ClientWithResponses) GetClientPoolWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetClientPoolResponse, error) {
+	rsp, err := c.GetClientPool(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClientPoolResponse(rsp)
+}
+
+// ListClustersWithResponse request returning *ListClustersResponse
+func (c *ClientWithResponses) ListClustersWithResponse(ctx context.Context, params *ListClustersParams, reqEditors ...RequestEditorFn) (*ListClustersResponse, error) {
+	rsp, err := c.ListClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClustersResponse(rsp)
+}
+
+// RegisterClusterWithBodyWithResponse request with arbitrary body returning *RegisterClusterResponse
+func (c *ClientWithResponses) RegisterClusterWithBodyWithResponse(ctx context.Context, params *RegisterClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterClusterResponse, error) {
+	rsp, err := c.RegisterClusterWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterClusterWithResponse(ctx context.Context, params *RegisterClusterParams, body RegisterClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterClusterResponse, error) {
+	rsp, err := c.RegisterCluster(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterClusterResponse(rsp)
+}
+
+// RegisterClusterFromConfigWithBodyWithResponse request with arbitrary body returning *RegisterClusterFromConfigResponse
+func (c *ClientWithResponses) RegisterClusterFromConfigWithBodyWithResponse(ctx context.Context, params *RegisterClusterFromConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterClusterFromConfigResponse, error) {
+	rsp, err := c.RegisterClusterFromConfigWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterClusterFromConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterClusterFromConfigWithResponse(ctx context.Context, params *RegisterClusterFromConfigParams, body RegisterClusterFromConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterClusterFromConfigResponse, error) {
+	rsp, err := c.RegisterClusterFromConfig(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterClusterFromConfigResponse(rsp)
+}
+
+// GetClusterWithResponse request returning *GetClusterResponse
+func (c *ClientWithResponses) GetClusterWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*GetClusterResponse, error) {
+	rsp, err := c.GetCluster(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterResponse(rsp)
+}
+
+// UpdateClusterWithBodyWithResponse request with arbitrary body returning *UpdateClusterResponse
+func (c *ClientWithResponses) UpdateClusterWithBodyWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
+	rsp, err := c.UpdateClusterWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClusterWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterParams, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
+	rsp, err := c.UpdateCluster(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterResponse(rsp)
+}
+
+// RunClusterCommandWithBodyWithResponse request with arbitrary body returning *RunClusterCommandResponse
+func (c *ClientWithResponses) RunClusterCommandWithBodyWithResponse(ctx context.Context, id ClusterID, params *RunClusterCommandParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunClusterCommandResponse, error) {
+	rsp, err := c.RunClusterCommandWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunClusterCommandResponse(rsp)
+}
+
+func (c *ClientWithResponses) RunClusterCommandWithResponse(ctx context.Context, id ClusterID, params *RunClusterCommandParams, body RunClusterCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunClusterCommandResponse, error) {
+	rsp, err := c.RunClusterCommand(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunClusterCommandResponse(rsp)
+}
+
+// ListClusterEventsWithResponse request returning *ListClusterEventsResponse
+func (c *ClientWithResponses) ListClusterEventsWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*ListClusterEventsResponse, error) {
+	rsp, err := c.ListClusterEvents(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterEventsResponse(rsp)
+}
+
+// UpdateClusterHostsWithBodyWithResponse request with arbitrary body returning *UpdateClusterHostsResponse
+func (c *ClientWithResponses) UpdateClusterHostsWithBodyWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterHostsResponse, error) {
+	rsp, err := c.UpdateClusterHostsWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterHostsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClusterHostsWithResponse(ctx context.Context, id ClusterID, params *UpdateClusterHostsParams, body UpdateClusterHostsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterHostsResponse, error) {
+	rsp, err := c.UpdateClusterHosts(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterHostsResponse(rsp)
+}
+
+// ListClusterOsdsWithResponse request returning *ListClusterOsdsResponse
+func (c *ClientWithResponses) ListClusterOsdsWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*ListClusterOsdsResponse, error) {
+	rsp, err := c.ListClusterOsds(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterOsdsResponse(rsp)
+}
+
+// GetClusterOverviewWithResponse request returning *GetClusterOverviewResponse
+func (c *ClientWithResponses) GetClusterOverviewWithResponse(ctx context.Context, id ClusterID, reqEditors ...RequestEditorFn) (*GetClusterOverviewResponse, error) {
+	rsp, err := c.GetClusterOverview(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterOverviewResponse(rsp)
+}
+
+// GetClusterUsageWithResponse request returning *GetClusterUsageResponse
+func (c *ClientWithResponses) GetClusterUsageWithResponse(ctx context.Context, id ClusterID, params *GetClusterUsageParams, reqEditors ...RequestEditorFn) (*GetClusterUsageResponse, error) {
+	rsp, err := c.GetClusterUsage(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterUsageResponse(rsp)
+}
+
+// ListCommandsWithResponse request returning *ListCommandsResponse
+func (c *ClientWithResponses) ListCommandsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCommandsResponse, error) {
+	rsp, err := c.ListCommands(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCommandsResponse(rsp)
+}
+
+// ExportClustersWithResponse request returning *ExportClustersResponse
+func (c *ClientWithResponses) ExportClustersWithResponse(ctx context.Context, params *ExportClustersParams, reqEditors ...RequestEditorFn) (*ExportClustersResponse, error) {
+	rsp, err := c.ExportClusters(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportClustersResponse(rsp)
+}
+
+// ImportClustersWithBodyWithResponse request with arbitrary body returning *ImportClustersResponse
+func (c *ClientWithResponses) ImportClustersWithBodyWithResponse(ctx context.Context, params *ImportClustersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportClustersResponse, error) {
+	rsp, err := c.ImportClustersWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportClustersResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportClustersWithResponse(ctx context.Context, params *ImportClustersParams, body ImportClustersJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportClustersResponse, error) {
+	rsp, err := c.ImportClusters(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportClustersResponse(rsp)
+}
+
+// GetJobWithResponse request returning *GetJobResponse
+func (c *ClientWithResponses) GetJobWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetJobResponse, error) {
+	rsp, err := c.GetJob(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobResponse(rsp)
+}
+
+// GetSummaryWithResponse request returning *GetSummaryResponse
+func (c *ClientWithResponses) GetSummaryWithResponse(ctx context.Context, params *GetSummaryParams, reqEditors ...RequestEditorFn) (*GetSummaryResponse, error) {
+	rsp, err := c.GetSummary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSummaryResponse(rsp)
+}
+
+// ParseListAuditEntriesResponse parses an HTTP response from a ListAuditEntriesWithResponse call
+func ParseListAuditEntriesResponse(rsp *http.Response) (*ListAuditEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAuditEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAvailabilityResponse parses an HTTP response from a GetAvailabilityWithResponse call
+func ParseGetAvailabilityResponse(rsp *http.Response) (*GetAvailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAvailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClusterAvailability
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClientPoolResponse parses an HTTP response from a GetClientPoolWithResponse call
+func ParseGetClientPoolResponse(rsp *http.Response) (*GetClientPoolResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClientPoolResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientPoolStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClustersResponse parses an HTTP response from a ListClustersWithResponse call
+func ParseListClustersResponse(rsp *http.Response) (*ListClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterClusterResponse parses an HTTP response from a RegisterClusterWithResponse call
+func ParseRegisterClusterResponse(rsp *http.Response) (*RegisterClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRegisterClusterFromConfigResponse parses an HTTP response from a RegisterClusterFromConfigWithResponse call
+func ParseRegisterClusterFromConfigResponse(rsp *http.Response) (*RegisterClusterFromConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RegisterClusterFromConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterResponse parses an HTTP response from a GetClusterWithResponse call
+func ParseGetClusterResponse(rsp *http.Response) (*GetClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClusterResponse parses an HTTP response from a UpdateClusterWithResponse call
+func ParseUpdateClusterResponse(rsp *http.Response) (*UpdateClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRunClusterCommandResponse parses an HTTP response from a RunClusterCommandWithResponse call
+func ParseRunClusterCommandResponse(rsp *http.Response) (*RunClusterCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunClusterCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CommandResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 502:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON502 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterEventsResponse parses an HTTP response from a ListClusterEventsWithResponse call
+func ParseListClusterEventsResponse(rsp *http.Response) (*ListClusterEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClusterHostsResponse parses an HTTP response from a UpdateClusterHostsWithResponse call
+func ParseUpdateClusterHostsResponse(rsp *http.Response) (*UpdateClusterHostsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClusterHostsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterOsdsResponse parses an HTTP response from a ListClusterOsdsWithResponse call
+func ParseListClusterOsdsResponse(rsp *http.Response) (*ListClusterOsdsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterOsdsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []OSD
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterOverviewResponse parses an HTTP response from a GetClusterOverviewWithResponse call
+func ParseGetClusterOverviewResponse(rsp *http.Response) (*GetClusterOverviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterOverviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Overview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterUsageResponse parses an HTTP response from a GetClusterUsageWithResponse call
+func ParseGetClusterUsageResponse(rsp *http.Response) (*GetClusterUsageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterUsageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsageTrend
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCommandsResponse parses an HTTP response from a ListCommandsWithResponse call
+func ParseListCommandsResponse(rsp *http.Response) (*ListCommandsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCommandsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CommandSpec
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportClustersResponse parses an HTTP response from a ExportClustersWithResponse call
+func ParseExportClustersResponse(rsp *http.Response) (*ExportClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExportDocument
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportClustersResponse parses an HTTP response from a ImportClustersWithResponse call
+func ParseImportClustersResponse(rsp *http.Response) (*ImportClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJobResponse parses an HTTP response from a GetJobWithResponse call
+func ParseGetJobResponse(rsp *http.Response) (*GetJobResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSummaryResponse parses an HTTP response from a GetSummaryWithResponse call
+func ParseGetSummaryResponse(rsp *http.Response) (*GetSummaryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSummaryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FleetSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+
+	// (GET /audit)
+	ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams)
+
+	// (GET /availability)
+	GetAvailability(w http.ResponseWriter, r *http.Request, params GetAvailabilityParams)
+
+	// (GET /client-pool)
+	GetClientPool(w http.ResponseWriter, r *http.Request)
+
+	// (GET /clusters)
+	ListClusters(w http.ResponseWriter, r *http.Request, params ListClustersParams)
+
+	// (POST /clusters)
+	RegisterCluster(w http.ResponseWriter, r *http.Request, params RegisterClusterParams)
+
+	// (POST /clusters/from-config)
+	RegisterClusterFromConfig(w http.ResponseWriter, r *http.Request, params RegisterClusterFromConfigParams)
+
+	// (GET /clusters/{id})
+	GetCluster(w http.ResponseWriter, r *http.Request, id ClusterID)
+
+	// (PATCH /clusters/{id})
+	UpdateCluster(w http.ResponseWriter, r *http.Request, id ClusterID, params UpdateClusterParams)
+
+	// (POST /clusters/{id}/commands)
+	RunClusterCommand(w http.ResponseWriter, r *http.Request, id ClusterID, params RunClusterCommandParams)
+
+	// (GET /clusters/{id}/events)
+	ListClusterEvents(w http.ResponseWriter, r *http.Request, id ClusterID)
+
+	// (PUT /clusters/{id}/hosts)
+	UpdateClusterHosts(w http.ResponseWriter, r *http.Request, id ClusterID, params UpdateClusterHostsParams)
+
+	// (GET /clusters/{id}/osds)
+	ListClusterOsds(w http.ResponseWriter, r *http.Request, id ClusterID)
+
+	// (GET /clusters/{id}/overview)
+	GetClusterOverview(w http.ResponseWriter, r *http.Request, id ClusterID)
+
+	// (GET /clusters/{id}/usage)
+	GetClusterUsage(w http.ResponseWriter, r *http.Request, id ClusterID, params GetClusterUsageParams)
+
+	// (GET /commands)
+	ListCommands(w http.ResponseWriter, r *http.Request)
+
+	// (GET /export)
+	ExportClusters(w http.ResponseWriter, r *http.Request, params ExportClustersParams)
+
+	// (POST /import)
+	ImportClusters(w http.ResponseWriter, r *http.Request, params ImportClustersParams)
+
+	// (GET /jobs/{id})
+	GetJob(w http.ResponseWriter, r *http.Request, id string)
+
+	// (GET /summary)
+	GetSummary(w http.ResponseWriter, r *http.Request, params GetSummaryParams)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// (GET /audit)
+func (_ Unimplemented) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /availability)
+func (_ Unimplemented) GetAvailability(w http.ResponseWriter, r *http.Request, params GetAvailabilityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /client-pool)
+func (_ Unimplemented) GetClientPool(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /clusters)
+func (_ Unimplemented) ListClusters(w http.ResponseWriter, r *http.Request, params ListClustersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /clusters)
+func (_ Unimplemented) RegisterCluster(w http.ResponseWriter, r *http.Request, params RegisterClusterParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /clusters/from-config)
+func (_ Unimplemented) RegisterClusterFromConfig(w http.ResponseWriter, r *http.Request, params RegisterClusterFromConfigParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /clusters/{id})
+func (_ Unimplemented) GetCluster(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /clusters/{id})
+func (_ Unimplemented) UpdateCluster(w http.ResponseWriter, r *http.Request, id ClusterID, params UpdateClusterParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /clusters/{id}/commands)
+func (_ Unimplemented) RunClusterCommand(w http.ResponseWriter, r *http.Request, id ClusterID, params RunClusterCommandParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /clusters/{id}/events)
+func (_ Unimplemented) ListClusterEvents(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /clusters/{id}/hosts)
+func (_ Unimplemented) UpdateClusterHosts(w http.ResponseWriter, r *http.Request, id ClusterID, params UpdateClusterHostsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /clusters/{id}/osds)
+func (_ Unimplemented) ListClusterOsds(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /clusters/{id}/overview)
+func (_ Unimplemented) GetClusterOverview(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /clusters/{id}/usage)
+func (_ Unimplemented) GetClusterUsage(w http.ResponseWriter, r *http.Request, id ClusterID, params GetClusterUsageParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /commands)
+func (_ Unimplemented) ListCommands(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /export)
+func (_ Unimplemented) ExportClusters(w http.ResponseWriter, r *http.Request, params ExportClustersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /import)
+func (_ Unimplemented) ImportClusters(w http.ResponseWriter, r *http.Request, params ImportClustersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /jobs/{id})
+func (_ Unimplemented) GetJob(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /summary)
+func (_ Unimplemented) GetSummary(w http.ResponseWriter, r *http.Request, params GetSummaryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListAuditEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditEntriesParams
+
+	// ------------- Optional query parameter "cluster_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cluster_id", r.URL.Query(), &params.ClusterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditEntries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAvailability operation middleware
+func (siw *ServerInterfaceWrapper) GetAvailability(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAvailabilityParams
+
+	// ------------- Optional query parameter "cluster_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cluster_id", r.URL.Query(), &params.ClusterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cluster_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAvailability(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetClientPool operation middleware
+func (siw *ServerInterfaceWrapper) GetClientPool(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClientPool(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClusters operation middleware
+func (siw *ServerInterfaceWrapper) ListClusters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClustersParams
+
+	// ------------- Optional query parameter "selector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "selector", r.URL.Query(), &params.Selector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "selector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClusters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterCluster operation middleware
+func (siw *ServerInterfaceWrapper) RegisterCluster(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RegisterClusterParams
+
+	// ------------- Optional query parameter "async" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "async", r.URL.Query(), &params.Async)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "async", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Cepher-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Actor")]; found {
+		var XCepherActor Actor
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Actor", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Actor", valueList[0], &XCepherActor, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Actor", Err: err})
+			return
+		}
+
+		params.XCepherActor = &XCepherActor
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterCluster(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RegisterClusterFromConfig operation middleware
+func (siw *ServerInterfaceWrapper) RegisterClusterFromConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RegisterClusterFromConfigParams
+
+	// ------------- Optional query parameter "async" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "async", r.URL.Query(), &params.Async)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "async", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Cepher-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Actor")]; found {
+		var XCepherActor Actor
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Actor", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Actor", valueList[0], &XCepherActor, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Actor", Err: err})
+			return
+		}
+
+		params.XCepherActor = &XCepherActor
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RegisterClusterFromConfig(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCluster operation middleware
+func (siw *ServerInterfaceWrapper) GetCluster(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCluster(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCluster operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCluster(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateClusterParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Cepher-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Actor")]; found {
+		var XCepherActor Actor
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Actor", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Actor", valueList[0], &XCepherActor, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Actor", Err: err})
+			return
+		}
+
+		params.XCepherActor = &XCepherActor
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCluster(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunClusterCommand operation middleware
+func (siw *ServerInterfaceWrapper) RunClusterCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunClusterCommandParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Cepher-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Actor")]; found {
+		var XCepherActor Actor
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Actor", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Actor", valueList[0], &XCepherActor, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Actor", Err: err})
+			return
+		}
+
+		params.XCepherActor = &XCepherActor
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunClusterCommand(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClusterEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClusterEvents(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateClusterHosts operation middleware
+func (siw *ServerInterfaceWrapper) UpdateClusterHosts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateClusterHostsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Cepher-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Actor")]; found {
+		var XCepherActor Actor
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Actor", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Actor", valueList[0], &XCepherActor, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Actor", Err: err})
+			return
+		}
+
+		params.XCepherActor = &XCepherActor
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateClusterHosts(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClusterOsds operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterOsds(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClusterOsds(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetClusterOverview operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterOverview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClusterOverview(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetClusterUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterUsage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ClusterID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClusterUsageParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClusterUsage(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCommands operation middleware
+func (siw *ServerInterfaceWrapper) ListCommands(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCommands(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportClusters operation middleware
+func (siw *ServerInterfaceWrapper) ExportClusters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportClustersParams
+
+	// ------------- Optional query parameter "key_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "key_mode", r.URL.Query(), &params.KeyMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key_mode", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Cepher-Passphrase" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Passphrase")]; found {
+		var XCepherPassphrase Passphrase
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Passphrase", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Passphrase", valueList[0], &XCepherPassphrase, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Passphrase", Err: err})
+			return
+		}
+
+		params.XCepherPassphrase = &XCepherPassphrase
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportClusters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportClusters operation middleware
+func (siw *ServerInterfaceWrapper) ImportClusters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportClustersParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "on_conflict" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "on_conflict", r.URL.Query(), &params.OnConflict)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "on_conflict", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Cepher-Actor" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Actor")]; found {
+		var XCepherActor Actor
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Actor", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Actor", valueList[0], &XCepherActor, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Actor", Err: err})
+			return
+		}
+
+		params.XCepherActor = &XCepherActor
+
+	}
+
+	// ------------- Optional header parameter "X-Cepher-Passphrase" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Cepher-Passphrase")]; found {
+		var XCepherPassphrase Passphrase
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Cepher-Passphrase", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Cepher-Passphrase", valueList[0], &XCepherPassphrase, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Cepher-Passphrase", Err: err})
+			return
+		}
+
+		params.XCepherPassphrase = &XCepherPassphrase
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportClusters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetJob operation middleware
+func (siw *ServerInterfaceWrapper) GetJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJob(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSummaryParams
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSummary(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.ListAuditEntries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/availability", wrapper.GetAvailability)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/client-pool", wrapper.GetClientPool)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/clusters", wrapper.ListClusters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/clusters", wrapper.RegisterCluster)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/clusters/from-config", wrapper.RegisterClusterFromConfig)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/clusters/{id}", wrapper.GetCluster)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/clusters/{id}", wrapper.UpdateCluster)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/clusters/{id}/commands", wrapper.RunClusterCommand)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/clusters/{id}/events", wrapper.ListClusterEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/clusters/{id}/hosts", wrapper.UpdateClusterHosts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/clusters/{id}/osds", wrapper.ListClusterOsds)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/clusters/{id}/overview", wrapper.GetClusterOverview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/clusters/{id}/usage", wrapper.GetClusterUsage)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/commands", wrapper.ListCommands)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export", wrapper.ExportClusters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/import", wrapper.ImportClusters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/jobs/{id}", wrapper.GetJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/summary", wrapper.GetSummary)
+	})
+
+	return r
+}
+
+type ListAuditEntriesRequestObject struct {
+	Params ListAuditEntriesParams
+}
+
+type ListAuditEntriesResponseObject interface {
+	VisitListAuditEntriesResponse(w http.ResponseWriter) error
+}
+
+type ListAuditEntries200JSONResponse []AuditEntry
+
+func (response ListAuditEntries200JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries400JSONResponse Error
+
+func (response ListAuditEntries400JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAuditEntries500JSONResponse Error
+
+func (response ListAuditEntries500JSONResponse) VisitListAuditEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAvailabilityRequestObject struct {
+	Params GetAvailabilityParams
+}
+
+type GetAvailabilityResponseObject interface {
+	VisitGetAvailabilityResponse(w http.ResponseWriter) error
+}
+
+type GetAvailability200JSONResponse []ClusterAvailability
+
+func (response GetAvailability200JSONResponse) VisitGetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAvailability200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetAvailability200TextcsvResponse) VisitGetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetAvailability400JSONResponse Error
+
+func (response GetAvailability400JSONResponse) VisitGetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAvailability404JSONResponse Error
+
+func (response GetAvailability404JSONResponse) VisitGetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAvailability500JSONResponse Error
+
+func (response GetAvailability500JSONResponse) VisitGetAvailabilityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClientPoolRequestObject struct {
+}
+
+type GetClientPoolResponseObject interface {
+	VisitGetClientPoolResponse(w http.ResponseWriter) error
+}
+
+type GetClientPool200JSONResponse ClientPoolStats
+
+func (response GetClientPool200JSONResponse) VisitGetClientPoolResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClustersRequestObject struct {
+	Params ListClustersParams
+}
+
+type ListClustersResponseObject interface {
+	VisitListClustersResponse(w http.ResponseWriter) error
+}
+
+type ListClusters200ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListClusters200JSONResponse struct {
+	Body    []Cluster
+	Headers ListClusters200ResponseHeaders
+}
+
+func (response ListClusters200JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListClusters204ResponseHeaders struct {
+	XTotalCount int
+}
+
+type ListClusters204Response struct {
+	Headers ListClusters204ResponseHeaders
+}
+
+func (response ListClusters204Response) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Total-Count", fmt.Sprint(response.Headers.XTotalCount))
+	w.WriteHeader(204)
+	return nil
+}
+
+type ListClusters400JSONResponse Error
+
+func (response ListClusters400JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusters500JSONResponse Error
+
+func (response ListClusters500JSONResponse) VisitListClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterClusterRequestObject struct {
+	Params RegisterClusterParams
+	Body   *RegisterClusterJSONRequestBody
+}
+
+type RegisterClusterResponseObject interface {
+	VisitRegisterClusterResponse(w http.ResponseWriter) error
+}
+
+type RegisterCluster201JSONResponse Cluster
+
+func (response RegisterCluster201JSONResponse) VisitRegisterClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterCluster202JSONResponse Job
+
+func (response RegisterCluster202JSONResponse) VisitRegisterClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterCluster400JSONResponse Error
+
+func (response RegisterCluster400JSONResponse) VisitRegisterClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterCluster409JSONResponse Error
+
+func (response RegisterCluster409JSONResponse) VisitRegisterClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterCluster500JSONResponse Error
 
 func (response RegisterCluster500JSONResponse) VisitRegisterClusterResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterCluster502JSONResponse Error
+
+func (response RegisterCluster502JSONResponse) VisitRegisterClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterClusterFromConfigRequestObject struct {
+	Params        RegisterClusterFromConfigParams
+	JSONBody      *RegisterClusterFromConfigJSONRequestBody
+	MultipartBody *multipart.Reader
+}
+
+type RegisterClusterFromConfigResponseObject interface {
+	VisitRegisterClusterFromConfigResponse(w http.ResponseWriter) error
+}
+
+type RegisterClusterFromConfig201JSONResponse Cluster
+
+func (response RegisterClusterFromConfig201JSONResponse) VisitRegisterClusterFromConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterClusterFromConfig202JSONResponse Job
+
+func (response RegisterClusterFromConfig202JSONResponse) VisitRegisterClusterFromConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterClusterFromConfig400JSONResponse Error
+
+func (response RegisterClusterFromConfig400JSONResponse) VisitRegisterClusterFromConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterClusterFromConfig409JSONResponse Error
+
+func (response RegisterClusterFromConfig409JSONResponse) VisitRegisterClusterFromConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterClusterFromConfig500JSONResponse Error
+
+func (response RegisterClusterFromConfig500JSONResponse) VisitRegisterClusterFromConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterClusterFromConfig502JSONResponse Error
+
+func (response RegisterClusterFromConfig502JSONResponse) VisitRegisterClusterFromConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterRequestObject struct {
+	Id ClusterID `json:"id"`
+}
+
+type GetClusterResponseObject interface {
+	VisitGetClusterResponse(w http.ResponseWriter) error
+}
+
+type GetCluster200JSONResponse Cluster
+
+func (response GetCluster200JSONResponse) VisitGetClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCluster404JSONResponse Error
+
+func (response GetCluster404JSONResponse) VisitGetClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCluster500JSONResponse Error
+
+func (response GetCluster500JSONResponse) VisitGetClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClusterRequestObject struct {
+	Id     ClusterID `json:"id"`
+	Params UpdateClusterParams
+	Body   *UpdateClusterJSONRequestBody
+}
+
+type UpdateClusterResponseObject interface {
+	VisitUpdateClusterResponse(w http.ResponseWriter) error
+}
+
+type UpdateCluster200JSONResponse Cluster
+
+func (response UpdateCluster200JSONResponse) VisitUpdateClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCluster400JSONResponse Error
+
+func (response UpdateCluster400JSONResponse) VisitUpdateClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCluster404JSONResponse Error
+
+func (response UpdateCluster404JSONResponse) VisitUpdateClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCluster500JSONResponse Error
+
+func (response UpdateCluster500JSONResponse) VisitUpdateClusterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunClusterCommandRequestObject struct {
+	Id     ClusterID `json:"id"`
+	Params RunClusterCommandParams
+	Body   *RunClusterCommandJSONRequestBody
+}
+
+type RunClusterCommandResponseObject interface {
+	VisitRunClusterCommandResponse(w http.ResponseWriter) error
+}
+
+type RunClusterCommand200JSONResponse CommandResult
+
+func (response RunClusterCommand200JSONResponse) VisitRunClusterCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunClusterCommand400JSONResponse Error
+
+func (response RunClusterCommand400JSONResponse) VisitRunClusterCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunClusterCommand404JSONResponse Error
+
+func (response RunClusterCommand404JSONResponse) VisitRunClusterCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunClusterCommand500JSONResponse Error
+
+func (response RunClusterCommand500JSONResponse) VisitRunClusterCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunClusterCommand502JSONResponse Error
+
+func (response RunClusterCommand502JSONResponse) VisitRunClusterCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterEventsRequestObject struct {
+	Id ClusterID `json:"id"`
+}
+
+type ListClusterEventsResponseObject interface {
+	VisitListClusterEventsResponse(w http.ResponseWriter) error
+}
+
+type ListClusterEvents200JSONResponse []Event
+
+func (response ListClusterEvents200JSONResponse) VisitListClusterEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterEvents404JSONResponse Error
+
+func (response ListClusterEvents404JSONResponse) VisitListClusterEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterEvents500JSONResponse Error
+
+func (response ListClusterEvents500JSONResponse) VisitListClusterEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClusterHostsRequestObject struct {
+	Id     ClusterID `json:"id"`
+	Params UpdateClusterHostsParams
+	Body   *UpdateClusterHostsJSONRequestBody
+}
+
+type UpdateClusterHostsResponseObject interface {
+	VisitUpdateClusterHostsResponse(w http.ResponseWriter) error
+}
+
+type UpdateClusterHosts200JSONResponse Cluster
+
+func (response UpdateClusterHosts200JSONResponse) VisitUpdateClusterHostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClusterHosts400JSONResponse Error
+
+func (response UpdateClusterHosts400JSONResponse) VisitUpdateClusterHostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClusterHosts404JSONResponse Error
+
+func (response UpdateClusterHosts404JSONResponse) VisitUpdateClusterHostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateClusterHosts500JSONResponse Error
+
+func (response UpdateClusterHosts500JSONResponse) VisitUpdateClusterHostsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterOsdsRequestObject struct {
+	Id ClusterID `json:"id"`
+}
+
+type ListClusterOsdsResponseObject interface {
+	VisitListClusterOsdsResponse(w http.ResponseWriter) error
+}
+
+type ListClusterOsds200JSONResponse []OSD
+
+func (response ListClusterOsds200JSONResponse) VisitListClusterOsdsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterOsds404JSONResponse Error
+
+func (response ListClusterOsds404JSONResponse) VisitListClusterOsdsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListClusterOsds500JSONResponse Error
+
+func (response ListClusterOsds500JSONResponse) VisitListClusterOsdsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterOverviewRequestObject struct {
+	Id ClusterID `json:"id"`
+}
+
+type GetClusterOverviewResponseObject interface {
+	VisitGetClusterOverviewResponse(w http.ResponseWriter) error
+}
+
+type GetClusterOverview200JSONResponse Overview
+
+func (response GetClusterOverview200JSONResponse) VisitGetClusterOverviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterOverview404JSONResponse Error
+
+func (response GetClusterOverview404JSONResponse) VisitGetClusterOverviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterOverview500JSONResponse Error
+
+func (response GetClusterOverview500JSONResponse) VisitGetClusterOverviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterUsageRequestObject struct {
+	Id     ClusterID `json:"id"`
+	Params GetClusterUsageParams
+}
+
+type GetClusterUsageResponseObject interface {
+	VisitGetClusterUsageResponse(w http.ResponseWriter) error
+}
+
+type GetClusterUsage200JSONResponse UsageTrend
+
+func (response GetClusterUsage200JSONResponse) VisitGetClusterUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterUsage404JSONResponse Error
+
+func (response GetClusterUsage404JSONResponse) VisitGetClusterUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClusterUsage500JSONResponse Error
+
+func (response GetClusterUsage500JSONResponse) VisitGetClusterUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommandsRequestObject struct {
+}
+
+type ListCommandsResponseObject interface {
+	VisitListCommandsResponse(w http.ResponseWriter) error
+}
+
+type ListCommands200JSONResponse []CommandSpec
+
+func (response ListCommands200JSONResponse) VisitListCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportClustersRequestObject struct {
+	Params ExportClustersParams
+}
+
+type ExportClustersResponseObject interface {
+	VisitExportClustersResponse(w http.ResponseWriter) error
+}
+
+type ExportClusters200JSONResponse ExportDocument
+
+func (response ExportClusters200JSONResponse) VisitExportClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportClusters400JSONResponse Error
+
+func (response ExportClusters400JSONResponse) VisitExportClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportClusters500JSONResponse Error
+
+func (response ExportClusters500JSONResponse) VisitExportClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportClustersRequestObject struct {
+	Params ImportClustersParams
+	Body   *ImportClustersJSONRequestBody
+}
+
+type ImportClustersResponseObject interface {
+	VisitImportClustersResponse(w http.ResponseWriter) error
+}
+
+type ImportClusters200JSONResponse ImportReport
+
+func (response ImportClusters200JSONResponse) VisitImportClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportClusters400JSONResponse Error
+
+func (response ImportClusters400JSONResponse) VisitImportClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportClusters409JSONResponse Error
+
+func (response ImportClusters409JSONResponse) VisitImportClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ImportClusters500JSONResponse Error
+
+func (response ImportClusters500JSONResponse) VisitImportClustersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetJobResponseObject interface {
+	VisitGetJobResponse(w http.ResponseWriter) error
+}
+
+type GetJob200JSONResponse Job
+
+func (response GetJob200JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob404JSONResponse Error
+
+func (response GetJob404JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJob500JSONResponse Error
+
+func (response GetJob500JSONResponse) VisitGetJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummaryRequestObject struct {
+	Params GetSummaryParams
+}
+
+type GetSummaryResponseObject interface {
+	VisitGetSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetSummary200JSONResponse FleetSummary
+
+func (response GetSummary200JSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary400JSONResponse Error
+
+func (response GetSummary400JSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSummary500JSONResponse Error
+
+func (response GetSummary500JSONResponse) VisitGetSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /audit)
+	ListAuditEntries(ctx context.Context, request ListAuditEntriesRequestObject) (ListAuditEntriesResponseObject, error)
+
+	// (GET /availability)
+	GetAvailability(ctx context.Context, request GetAvailabilityRequestObject) (GetAvailabilityResponseObject, error)
+
+	// (GET /client-pool)
+	GetClientPool(ctx context.Context, request GetClientPoolRequestObject) (GetClientPoolResponseObject, error)
+
+	// (GET /clusters)
+	ListClusters(ctx context.Context, request ListClustersRequestObject) (ListClustersResponseObject, error)
+
+	// (POST /clusters)
+	RegisterCluster(ctx context.Context, request RegisterClusterRequestObject) (RegisterClusterResponseObject, error)
+
+	// (POST /clusters/from-config)
+	RegisterClusterFromConfig(ctx context.Context, request RegisterClusterFromConfigRequestObject) (RegisterClusterFromConfigResponseObject, error)
+
+	// (GET /clusters/{id})
+	GetCluster(ctx context.Context, request GetClusterRequestObject) (GetClusterResponseObject, error)
+
+	// (PATCH /clusters/{id})
+	UpdateCluster(ctx context.Context, request UpdateClusterRequestObject) (UpdateClusterResponseObject, error)
+
+	// (POST /clusters/{id}/commands)
+	RunClusterCommand(ctx context.Context, request RunClusterCommandRequestObject) (RunClusterCommandResponseObject, error)
+
+	// (GET /clusters/{id}/events)
+	ListClusterEvents(ctx context.Context, request ListClusterEventsRequestObject) (ListClusterEventsResponseObject, error)
+
+	// (PUT /clusters/{id}/hosts)
+	UpdateClusterHosts(ctx context.Context, request UpdateClusterHostsRequestObject) (UpdateClusterHostsResponseObject, error)
+
+	// (GET /clusters/{id}/osds)
+	ListClusterOsds(ctx context.Context, request ListClusterOsdsRequestObject) (ListClusterOsdsResponseObject, error)
+
+	// (GET /clusters/{id}/overview)
+	GetClusterOverview(ctx context.Context, request GetClusterOverviewRequestObject) (GetClusterOverviewResponseObject, error)
+
+	// (GET /clusters/{id}/usage)
+	GetClusterUsage(ctx context.Context, request GetClusterUsageRequestObject) (GetClusterUsageResponseObject, error)
+
+	// (GET /commands)
+	ListCommands(ctx context.Context, request ListCommandsRequestObject) (ListCommandsResponseObject, error)
+
+	// (GET /export)
+	ExportClusters(ctx context.Context, request ExportClustersRequestObject) (ExportClustersResponseObject, error)
+
+	// (POST /import)
+	ImportClusters(ctx context.Context, request ImportClustersRequestObject) (ImportClustersResponseObject, error)
+
+	// (GET /jobs/{id})
+	GetJob(ctx context.Context, request GetJobRequestObject) (GetJobResponseObject, error)
+
+	// (GET /summary)
+	GetSummary(ctx context.Context, request GetSummaryRequestObject) (GetSummaryResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAuditEntries operation middleware
+func (sh *strictHandler) ListAuditEntries(w http.ResponseWriter, r *http.Request, params ListAuditEntriesParams) {
+	var request ListAuditEntriesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAuditEntries(ctx, request.(ListAuditEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAuditEntries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAuditEntriesResponseObject); ok {
+		if err := validResponse.VisitListAuditEntriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAvailability operation middleware
+func (sh *strictHandler) GetAvailability(w http.ResponseWriter, r *http.Request, params GetAvailabilityParams) {
+	var request GetAvailabilityRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAvailability(ctx, request.(GetAvailabilityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAvailability")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAvailabilityResponseObject); ok {
+		if err := validResponse.VisitGetAvailabilityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClientPool operation middleware
+func (sh *strictHandler) GetClientPool(w http.ResponseWriter, r *http.Request) {
+	var request GetClientPoolRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClientPool(ctx, request.(GetClientPoolRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClientPool")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClientPoolResponseObject); ok {
+		if err := validResponse.VisitGetClientPoolResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListClusters operation middleware
+func (sh *strictHandler) ListClusters(w http.ResponseWriter, r *http.Request, params ListClustersParams) {
+	var request ListClustersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClusters(ctx, request.(ListClustersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClusters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClustersResponseObject); ok {
+		if err := validResponse.VisitListClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterCluster operation middleware
+func (sh *strictHandler) RegisterCluster(w http.ResponseWriter, r *http.Request, params RegisterClusterParams) {
+	var request RegisterClusterRequestObject
+
+	request.Params = params
+
+	var body RegisterClusterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterCluster(ctx, request.(RegisterClusterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterCluster")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterClusterResponseObject); ok {
+		if err := validResponse.VisitRegisterClusterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RegisterClusterFromConfig operation middleware
+func (sh *strictHandler) RegisterClusterFromConfig(w http.ResponseWriter, r *http.Request, params RegisterClusterFromConfigParams) {
+	var request RegisterClusterFromConfigRequestObject
+
+	request.Params = params
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+
+		var body RegisterClusterFromConfigJSONRequestBody
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if reader, err := r.MultipartReader(); err != nil {
+			sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode multipart body: %w", err))
+			return
+		} else {
+			request.MultipartBody = reader
+		}
+	}
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RegisterClusterFromConfig(ctx, request.(RegisterClusterFromConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RegisterClusterFromConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RegisterClusterFromConfigResponseObject); ok {
+		if err := validResponse.VisitRegisterClusterFromConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// GetCluster operation middleware
+func (sh *strictHandler) GetCluster(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	var request GetClusterRequestObject
 
-	// (GET /clusters)
-	ListClusters(ctx context.Context, request ListClustersRequestObject) (ListClustersResponseObject, error)
+	request.Id = id
 
-	// (POST /clusters)
-	RegisterCluster(ctx context.Context, request RegisterClusterRequestObject) (RegisterClusterResponseObject, error)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCluster(ctx, request.(GetClusterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCluster")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClusterResponseObject); ok {
+		if err := validResponse.VisitGetClusterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// UpdateCluster operation middleware
+func (sh *strictHandler) UpdateCluster(w http.ResponseWriter, r *http.Request, id ClusterID, params UpdateClusterParams) {
+	var request UpdateClusterRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.Id = id
+	request.Params = params
+
+	var body UpdateClusterJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCluster(ctx, request.(UpdateClusterRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCluster")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateClusterResponseObject); ok {
+		if err := validResponse.VisitUpdateClusterResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// RunClusterCommand operation middleware
+func (sh *strictHandler) RunClusterCommand(w http.ResponseWriter, r *http.Request, id ClusterID, params RunClusterCommandParams) {
+	var request RunClusterCommandRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body RunClusterCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunClusterCommand(ctx, request.(RunClusterCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunClusterCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunClusterCommandResponseObject); ok {
+		if err := validResponse.VisitRunClusterCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// ListClusterEvents operation middleware
+func (sh *strictHandler) ListClusterEvents(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	var request ListClusterEventsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListClusterEvents(ctx, request.(ListClusterEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListClusterEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListClusterEventsResponseObject); ok {
+		if err := validResponse.VisitListClusterEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// UpdateClusterHosts operation middleware
+func (sh *strictHandler) UpdateClusterHosts(w http.ResponseWriter, r *http.Request, id ClusterID, params UpdateClusterHostsParams) {
+	var request UpdateClusterHostsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	var body UpdateClusterHostsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateClusterHosts(ctx, request.(UpdateClusterHostsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateClusterHosts")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateClusterHostsResponseObject); ok {
+		if err := validResponse.VisitUpdateClusterHostsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListClusters operation middleware
-func (sh *strictHandler) ListClusters(w http.ResponseWriter, r *http.Request) {
-	var request ListClustersRequestObject
+// ListClusterOsds operation middleware
+func (sh *strictHandler) ListClusterOsds(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	var request ListClusterOsdsRequestObject
+
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListClusters(ctx, request.(ListClustersRequestObject))
+		return sh.ssi.ListClusterOsds(ctx, request.(ListClusterOsdsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListClusters")
+		handler = middleware(handler, "ListClusterOsds")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListClustersResponseObject); ok {
-		if err := validResponse.VisitListClustersResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListClusterOsdsResponseObject); ok {
+		if err := validResponse.VisitListClusterOsdsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -364,11 +6484,116 @@ func (sh *strictHandler) ListClusters(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RegisterCluster operation middleware
-func (sh *strictHandler) RegisterCluster(w http.ResponseWriter, r *http.Request) {
-	var request RegisterClusterRequestObject
+// GetClusterOverview operation middleware
+func (sh *strictHandler) GetClusterOverview(w http.ResponseWriter, r *http.Request, id ClusterID) {
+	var request GetClusterOverviewRequestObject
 
-	var body RegisterClusterJSONRequestBody
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClusterOverview(ctx, request.(GetClusterOverviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClusterOverview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClusterOverviewResponseObject); ok {
+		if err := validResponse.VisitGetClusterOverviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClusterUsage operation middleware
+func (sh *strictHandler) GetClusterUsage(w http.ResponseWriter, r *http.Request, id ClusterID, params GetClusterUsageParams) {
+	var request GetClusterUsageRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClusterUsage(ctx, request.(GetClusterUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClusterUsage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetClusterUsageResponseObject); ok {
+		if err := validResponse.VisitGetClusterUsageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCommands operation middleware
+func (sh *strictHandler) ListCommands(w http.ResponseWriter, r *http.Request) {
+	var request ListCommandsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCommands(ctx, request.(ListCommandsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCommands")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCommandsResponseObject); ok {
+		if err := validResponse.VisitListCommandsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportClusters operation middleware
+func (sh *strictHandler) ExportClusters(w http.ResponseWriter, r *http.Request, params ExportClustersParams) {
+	var request ExportClustersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportClusters(ctx, request.(ExportClustersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportClusters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportClustersResponseObject); ok {
+		if err := validResponse.VisitExportClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportClusters operation middleware
+func (sh *strictHandler) ImportClusters(w http.ResponseWriter, r *http.Request, params ImportClustersParams) {
+	var request ImportClustersRequestObject
+
+	request.Params = params
+
+	var body ImportClustersJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -376,18 +6601,70 @@ func (sh *strictHandler) RegisterCluster(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RegisterCluster(ctx, request.(RegisterClusterRequestObject))
+		return sh.ssi.ImportClusters(ctx, request.(ImportClustersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RegisterCluster")
+		handler = middleware(handler, "ImportClusters")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RegisterClusterResponseObject); ok {
-		if err := validResponse.VisitRegisterClusterResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportClustersResponseObject); ok {
+		if err := validResponse.VisitImportClustersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJob operation middleware
+func (sh *strictHandler) GetJob(w http.ResponseWriter, r *http.Request, id string) {
+	var request GetJobRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJob(ctx, request.(GetJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobResponseObject); ok {
+		if err := validResponse.VisitGetJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSummary operation middleware
+func (sh *strictHandler) GetSummary(w http.ResponseWriter, r *http.Request, params GetSummaryParams) {
+	var request GetSummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSummary(ctx, request.(GetSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSummary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSummaryResponseObject); ok {
+		if err := validResponse.VisitGetSummaryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/neatflowcv/cepher/api"
+	"github.com/neatflowcv/cepher/internal/app/flow"
+)
+
+// availabilityStatuses는 time-in-state를 보여주는 status와 그 순서이다.
+//
+//nolint:gochecknoglobals
+var availabilityStatuses = []api.ClusterStatus{
+	api.ClusterStatusHEALTHOK,
+	api.ClusterStatusHEALTHWARN,
+	api.ClusterStatusHEALTHERR,
+	api.ClusterStatusHEALTHUNKNOWN,
+	api.ClusterStatusPENDING,
+}
+
+func (h *Handler) GetAvailability(
+	ctx context.Context,
+	request api.GetAvailabilityRequestObject,
+) (api.GetAvailabilityResponseObject, error) {
+	query := &flow.AvailabilityQuery{ //nolint:exhaustruct
+		Now: time.Now(),
+	}
+	if request.Params.ClusterId != nil {
+		query.ClusterID = *request.Params.ClusterId
+	}
+
+	if request.Params.Since != nil {
+		query.Since = *request.Params.Since
+	}
+
+	if request.Params.Until != nil {
+		query.Until = *request.Params.Until
+	}
+
+	availabilities, err := h.service.ListAvailability(ctx, query)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if request.Params.Format != nil && *request.Params.Format == api.Csv {
+		data, err := newAvailabilityCSV(availabilities)
+		if err != nil {
+			return nil, err
+		}
+
+		return api.GetAvailability200TextcsvResponse{
+			Body:          bytes.NewReader(data),
+			ContentLength: int64(len(data)),
+		}, nil
+	}
+
+	ret := api.GetAvailability200JSONResponse{}
+	for _, availability := range availabilities {
+		ret = append(ret, newAPIAvailability(availability))
+	}
+
+	return ret, nil
+}
+
+func newAPIAvailability(availability *flow.ClusterAvailability) api.ClusterAvailability {
+	var states []api.TimeInState
+	for _, status := range availabilityStatuses {
+		states = append(states, api.TimeInState{
+			Status:  status,
+			Seconds: availability.Durations[string(status)].Seconds(),
+			Percent: percent(availability.Ratios[string(status)]),
+		})
+	}
+
+	var mttr *float64
+	if availability.ResolvedIncidents > 0 {
+		seconds := availability.MTTR.Seconds()
+		mttr = &seconds
+	}
+
+	return api.ClusterAvailability{
+		ClusterId:         availability.ClusterID,
+		Name:              availability.Name,
+		Since:             availability.Since,
+		Until:             availability.Until,
+		RecordedSeconds:   availability.Recorded.Seconds(),
+		States:            states,
+		Incidents:         availability.Incidents,
+		ResolvedIncidents: availability.ResolvedIncidents,
+		MttrSeconds:       mttr,
+	}
+}
+
+// newAvailabilityCSV는 cluster마다 한 줄인 CSV를 만든다. 표 계산 프로그램에서 바로 열 수 있도록 비율은 백분율이다.
+func newAvailabilityCSV(availabilities []*flow.ClusterAvailability) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	writer := csv.NewWriter(&buffer)
+
+	header := []string{"cluster_id", "name", "since", "until", "recorded_seconds"}
+	for _, status := range availabilityStatuses {
+		header = append(header, string(status)+"_percent")
+	}
+
+	header = append(header, "incidents", "resolved_incidents", "mttr_seconds")
+
+	err := writer.Write(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write csv header: %w", err)
+	}
+
+	for _, availability := range availabilities {
+		record := []string{
+			availability.ClusterID,
+			availability.Name,
+			availability.Since.Format(time.RFC3339),
+			availability.Until.Format(time.RFC3339),
+			formatFloat(availability.Recorded.Seconds()),
+		}
+
+		for _, status := range availabilityStatuses {
+			record = append(record, formatFloat(percent(availability.Ratios[string(status)])))
+		}
+
+		mttr := ""
+		if availability.ResolvedIncidents > 0 {
+			mttr = formatFloat(availability.MTTR.Seconds())
+		}
+
+		record = append(record,
+			strconv.Itoa(availability.Incidents),
+			strconv.Itoa(availability.ResolvedIncidents),
+			mttr,
+		)
+
+		err = writer.Write(record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write csv record: %w", err)
+		}
+	}
+
+	writer.Flush()
+
+	err = writer.Error()
+	if err != nil {
+		return nil, fmt.Errorf("failed to flush csv: %w", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func percent(ratio float64) float64 {
+	const (
+		hundred   = 100
+		precision = 1000
+	)
+
+	// 소수점 셋째 자리까지만 남긴다. 99.999%처럼 SLO를 표현하는 데 충분하다.
+	return math.Round(ratio*hundred*precision) / precision
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
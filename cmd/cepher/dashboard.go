@@ -0,0 +1,30 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"net/http"
+)
+
+// dashboard는 cepher API를 불러와 cluster 상태를 보여주는 정적 page이다.
+// 외부 CDN 없이 동작하도록 필요한 파일을 모두 binary에 넣는다.
+//
+//go:embed dashboard
+var dashboard embed.FS
+
+// dashboardHandler는 /dashboard/ 아래에서 dashboard 파일을 제공한다.
+func dashboardHandler() (http.Handler, error) {
+	files, err := fs.Sub(dashboard, "dashboard")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dashboard files: %w", err)
+	}
+
+	return http.StripPrefix("/dashboard/", http.FileServerFS(files)), nil
+}
+
+func redirectTo(path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, path, http.StatusFound)
+	}
+}
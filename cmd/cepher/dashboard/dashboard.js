@@ -0,0 +1,166 @@
+"use strict";
+
+// 외부 라이브러리 없이 cepher API만으로 화면을 만든다. 값은 모두 textContent로 넣는다.
+
+const statuses = ["HEALTH_OK", "HEALTH_WARN", "HEALTH_ERR", "HEALTH_UNKNOWN", "PENDING"];
+
+let timer = null;
+
+function element(tag, className, text) {
+  const ret = document.createElement(tag);
+  if (className) {
+    ret.className = className;
+  }
+  if (text !== undefined) {
+    ret.textContent = text;
+  }
+  return ret;
+}
+
+async function fetchJSON(path) {
+  const response = await fetch(path, { headers: { Accept: "application/json" } });
+  if (response.status === 204) {
+    return [];
+  }
+  if (!response.ok) {
+    let message = response.status + " " + response.statusText;
+    try {
+      const body = await response.json();
+      message = body.code + ": " + body.message;
+    } catch (e) {
+      // Error 형식이 아니면 상태 코드만 보여준다.
+    }
+    throw new Error(message);
+  }
+  return response.json();
+}
+
+function formatTime(value) {
+  if (!value) {
+    return "-";
+  }
+  return new Date(value).toLocaleString();
+}
+
+// healthChecks는 cluster detail의 health check를 심각한 것부터 정렬한다.
+function healthChecks(detail) {
+  if (!detail || typeof detail !== "object") {
+    return [];
+  }
+  return Object.entries(detail)
+    .map(([code, check]) => ({
+      code: code,
+      severity: (check && check.severity) || "",
+      message: (check && check.summary && check.summary.message) || "",
+    }))
+    .sort((a, b) => b.severity.localeCompare(a.severity) || a.code.localeCompare(b.code));
+}
+
+function renderSummary(summary) {
+  const section = document.getElementById("summary");
+  section.replaceChildren();
+
+  const total = element("div", "card");
+  total.append(element("div", "count", String(summary.total)), element("div", "muted", "전체"));
+  section.append(total);
+
+  for (const status of statuses) {
+    const count = summary.statuses[status] || 0;
+    if (status === "PENDING" && count === 0) {
+      continue;
+    }
+    const card = element("div", "card " + status);
+    card.append(element("div", "count", String(count)), element("div", "muted", status));
+    section.append(card);
+  }
+
+  const stable = element("div", "card HEALTH_OK");
+  stable.append(element("div", "count", String(summary.stable)), element("div", "muted", "안정"));
+  section.append(stable);
+}
+
+function renderClusters(clusters) {
+  const body = document.getElementById("clusters");
+  body.replaceChildren();
+
+  for (const cluster of clusters) {
+    const row = element("tr");
+
+    row.append(element("td", "", cluster.name));
+
+    const status = element("td");
+    status.append(element("span", "status " + cluster.status, cluster.status));
+    row.append(status);
+
+    row.append(element("td", cluster.is_stable ? "" : "muted", cluster.is_stable ? "yes" : "no"));
+
+    const reachability = element("td");
+    const state = cluster.reachability.state;
+    reachability.append(element("span", "status " + state, state));
+    if (cluster.reachability.last_error) {
+      reachability.title = cluster.reachability.last_error;
+    }
+    row.append(reachability);
+
+    const checks = element("td");
+    const list = element("ul", "checks");
+    for (const check of healthChecks(cluster.detail)) {
+      const item = element("li", check.severity, check.code);
+      if (check.message) {
+        item.append(element("span", "muted", " " + check.message));
+      }
+      list.append(item);
+    }
+    checks.append(list.childElementCount > 0 ? list : element("span", "muted", "-"));
+    row.append(checks);
+
+    row.append(element("td", "", formatTime(cluster.reachability.last_contact_time)));
+
+    const labels = element("td");
+    for (const [key, value] of Object.entries(cluster.labels || {}).sort()) {
+      labels.append(element("span", "label", key + "=" + value));
+    }
+    row.append(labels);
+
+    body.append(row);
+  }
+
+  document.getElementById("empty").hidden = clusters.length > 0;
+}
+
+async function refresh() {
+  const error = document.getElementById("error");
+  const name = document.getElementById("filter").value.trim();
+  const query = new URLSearchParams({ sort: "-status" });
+  if (name) {
+    query.set("name", name);
+  }
+
+  try {
+    const [summary, clusters] = await Promise.all([
+      fetchJSON("../summary"),
+      fetchJSON("../clusters?" + query.toString()),
+    ]);
+    renderSummary(summary);
+    renderClusters(clusters);
+    error.hidden = true;
+    document.getElementById("updated").textContent = "마지막 갱신 " + new Date().toLocaleTimeString();
+  } catch (e) {
+    error.textContent = "불러오지 못했습니다: " + e.message;
+    error.hidden = false;
+  }
+}
+
+function schedule() {
+  clearInterval(timer);
+  const seconds = Number(document.getElementById("interval").value);
+  if (seconds > 0) {
+    timer = setInterval(refresh, seconds * 1000);
+  }
+}
+
+document.getElementById("interval").addEventListener("change", schedule);
+document.getElementById("filter").addEventListener("input", refresh);
+
+refresh();
+schedule();
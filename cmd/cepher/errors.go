@@ -0,0 +1,111 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/neatflowcv/cepher/api"
+	"github.com/neatflowcv/cepher/internal/app/flow"
+	"github.com/neatflowcv/cepher/internal/pkg/client"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/repository"
+)
+
+// errorMapping은 sentinel 오류를 HTTP 상태와 오류 code로 바꾸는 규칙이다.
+type errorMapping struct {
+	target error
+	status int
+	code   api.ErrorCode
+}
+
+// errorMappings는 위에서부터 먼저 맞는 규칙을 쓴다.
+// 더 구체적인 오류를 감싸서 반환하는 경우가 있으므로 구체적인 것을 앞에 둔다.
+//
+//nolint:gochecknoglobals
+var errorMappings = []errorMapping{
+	{target: flow.ErrHostsRejected, status: http.StatusBadRequest, code: api.HOSTSREJECTED},
+	{target: domain.ErrInvalidParameter, status: http.StatusBadRequest, code: api.INVALIDPARAMETER},
+	{target: domain.ErrCommandNotAllowed, status: http.StatusBadRequest, code: api.COMMANDNOTALLOWED},
+	{target: flow.ErrUnsupportedVersion, status: http.StatusBadRequest, code: api.UNSUPPORTEDVERSION},
+	{target: repository.ErrClusterNotFound, status: http.StatusNotFound, code: api.CLUSTERNOTFOUND},
+	{target: repository.ErrOverviewNotFound, status: http.StatusNotFound, code: api.OVERVIEWNOTFOUND},
+	{target: repository.ErrJobNotFound, status: http.StatusNotFound, code: api.JOBNOTFOUND},
+	{target: repository.ErrClusterAlreadyExists, status: http.StatusConflict, code: api.CLUSTERALREADYEXISTS},
+	{target: flow.ErrImportConflict, status: http.StatusConflict, code: api.IMPORTCONFLICT},
+	{target: domain.ErrIdentityMismatch, status: http.StatusConflict, code: api.CLUSTERIDENTITYMISMATCH},
+	{target: client.ErrAuthFailure, status: http.StatusBadGateway, code: api.CLUSTERAUTHFAILED},
+	// container runtime 오류는 cluster가 아니라 cepher 쪽 문제이므로 접근 실패보다 먼저 본다.
+	{target: client.ErrRuntime, status: http.StatusInternalServerError, code: api.INTERNAL},
+	{target: flow.ErrClusterUnreachable, status: http.StatusBadGateway, code: api.CLUSTERUNREACHABLE},
+	{target: client.ErrTimeout, status: http.StatusBadGateway, code: api.CLUSTERUNREACHABLE},
+	{target: client.ErrQuorumLost, status: http.StatusBadGateway, code: api.CLUSTERUNREACHABLE},
+	{target: flow.ErrCommandFailed, status: http.StatusBadGateway, code: api.COMMANDFAILED},
+}
+
+// errorWriter는 handler가 반환한 오류를 Error 응답으로 쓴다.
+type errorWriter struct {
+	logger *slog.Logger
+}
+
+func newErrorWriter(logger *slog.Logger) *errorWriter {
+	return &errorWriter{
+		logger: logger,
+	}
+}
+
+// writeRequestError는 요청 parameter나 body를 해석하지 못한 오류를 쓴다.
+func (e *errorWriter) writeRequestError(w http.ResponseWriter, r *http.Request, err error) {
+	e.write(w, r, http.StatusBadRequest, api.Error{
+		Code:    api.INVALIDREQUEST,
+		Field:   nil,
+		Message: err.Error(),
+	})
+}
+
+// writeError는 handler가 반환한 오류를 errorMappings에 따라 쓴다. 맞는 규칙이 없으면 500이다.
+func (e *errorWriter) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	status, body := newAPIError(err)
+	if status == http.StatusInternalServerError {
+		e.logger.ErrorContext(r.Context(), "failed to handle request", "error", err)
+	}
+
+	e.write(w, r, status, body)
+}
+
+func (e *errorWriter) write(w http.ResponseWriter, r *http.Request, status int, body api.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(body)
+	if err != nil {
+		e.logger.ErrorContext(r.Context(), "failed to write error response", "error", err)
+	}
+}
+
+func newAPIError(err error) (int, api.Error) {
+	body := api.Error{
+		Code:    api.INTERNAL,
+		Field:   nil,
+		Message: err.Error(),
+	}
+
+	for _, mapping := range errorMappings {
+		if !errors.Is(err, mapping.target) {
+			continue
+		}
+
+		body.Code = mapping.code
+
+		var parameterError *domain.ParameterError
+		if mapping.code == api.INVALIDPARAMETER && errors.As(err, &parameterError) {
+			field := parameterError.Field()
+			body.Field = &field
+		}
+
+		return mapping.status, body
+	}
+
+	return http.StatusInternalServerError, body
+}
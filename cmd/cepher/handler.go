@@ -2,33 +2,53 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
+	"mime/multipart"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-co-op/gocron/v2"
 	"github.com/google/uuid"
 	"github.com/neatflowcv/cepher/api"
 	"github.com/neatflowcv/cepher/internal/app/flow"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/logging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ api.StrictServerInterface = (*Handler)(nil)
 
 type Handler struct {
-	service       *flow.Service
-	scheduler     gocron.Scheduler
+	service   *flow.Service
+	logger    *slog.Logger
+	scheduler gocron.Scheduler
+	// jobSliders는 scheduler, 등록 job, 요청 goroutine이 함께 쓰므로 jobSlidersMu로 보호한다.
 	jobSliders    map[string]*Slider
+	jobSlidersMu  sync.Mutex
 	levelDuration map[int]time.Duration
+	dashboard     http.Handler
 }
 
-func NewHandler(service *flow.Service) (*Handler, error) {
+func NewHandler(service *flow.Service, logger *slog.Logger) (*Handler, error) {
 	scheduler, err := gocron.NewScheduler()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create scheduler: %w", err)
 	}
 
+	dashboard, err := dashboardHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	const (
 		stableDuration = 6 * time.Minute
 		warnDuration   = 3 * time.Minute
@@ -37,6 +57,7 @@ func NewHandler(service *flow.Service) (*Handler, error) {
 
 	handler := &Handler{
 		service:    service,
+		logger:     logger,
 		scheduler:  scheduler,
 		jobSliders: make(map[string]*Slider),
 		levelDuration: map[int]time.Duration{
@@ -44,30 +65,62 @@ func NewHandler(service *flow.Service) (*Handler, error) {
 			1: warnDuration,
 			2: errDuration,
 		},
+		dashboard: dashboard,
 	}
 
-	clusters, err := service.ListClusters(context.Background())
+	clusters, err := service.ListClusters(context.Background(), time.Now())
 	if err != nil {
 		return nil, fmt.Errorf("failed to list clusters: %w", err)
 	}
 
 	for _, cluster := range clusters {
+		if cluster.IsPending() {
+			continue
+		}
+
 		handler.addJob(cluster.ID)
 	}
 
+	// 서버가 멈춰 끝나지 못한 등록 job을 이어서 진행한다.
+	jobs, err := service.ListUnfinishedJobs(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unfinished jobs: %w", err)
+	}
+
+	for _, job := range jobs {
+		go handler.runRegisterJob(job)
+	}
+
 	scheduler.Start()
 
 	return handler, nil
 }
 
 func (h *Handler) Get() http.Handler {
-	return api.HandlerFromMux(api.NewStrictHandler(h, nil), chi.NewMux())
+	mux := chi.NewMux()
+	mux.Use(middleware.RequestID, requestLogger(h.logger))
+
+	mux.Handle("/dashboard/*", h.dashboard)
+	mux.Get("/dashboard", redirectTo("/dashboard/"))
+	mux.Get("/", redirectTo("/dashboard/"))
+
+	errorWriter := newErrorWriter(h.logger)
+
+	strict := api.NewStrictHandlerWithOptions(h, []api.StrictMiddlewareFunc{traceOperation}, api.StrictHTTPServerOptions{
+		RequestErrorHandlerFunc:  errorWriter.writeRequestError,
+		ResponseErrorHandlerFunc: errorWriter.writeError,
+	})
+
+	return api.HandlerWithOptions(strict, api.ChiServerOptions{ //nolint:exhaustruct
+		BaseRouter:       mux,
+		ErrorHandlerFunc: errorWriter.writeRequestError,
+	})
 }
 
 func (h *Handler) Close() {
 	err := h.scheduler.Shutdown()
 	if err != nil {
-		log.Printf("failed to shutdown scheduler: %v", err)
+		h.logger.Error("failed to shutdown scheduler", "error", err)
 	}
 }
 
@@ -75,73 +128,574 @@ func (h *Handler) RegisterCluster(
 	ctx context.Context,
 	request api.RegisterClusterRequestObject,
 ) (api.RegisterClusterResponseObject, error) {
-	log.Println("RegisterCluster")
-
-	cluster, err := h.service.RegisterCluster(ctx, &flow.RegisterCluster{
+	registerCluster := &flow.RegisterCluster{ //nolint:exhaustruct
 		Name:  request.Body.Name,
 		Hosts: request.Body.Hosts,
 		Key:   request.Body.Key,
+		Actor: actor(request.Params.XCepherActor),
 		Now:   time.Now(),
-	})
+	}
+	if request.Body.Entity != nil {
+		registerCluster.Entity = *request.Body.Entity
+	}
+
+	if request.Body.Labels != nil {
+		registerCluster.Labels = *request.Body.Labels
+	}
+
+	if request.Params.Async != nil && *request.Params.Async {
+		job, err := h.service.StartRegisterCluster(ctx, registerCluster)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		go h.runRegisterJob(job) //nolint:contextcheck
+
+		return api.RegisterCluster202JSONResponse(newAPIJob(job)), nil
+	}
+
+	cluster, err := h.service.RegisterCluster(ctx, registerCluster)
 	if err != nil {
-		return api.RegisterCluster500JSONResponse{ //nolint:nilerr
-			Message: err.Error(),
-		}, nil
+		return nil, err //nolint:wrapcheck
 	}
 
 	h.addJob(cluster.ID) //nolint:contextcheck
 
-	return api.RegisterCluster201JSONResponse{
-		Id:       cluster.ID,
-		Name:     cluster.Name,
-		Status:   api.ClusterStatus(cluster.Status),
-		IsStable: cluster.IsStable,
-		Detail:   &cluster.Detail,
-	}, nil
+	return api.RegisterCluster201JSONResponse(newAPICluster(cluster)), nil
+}
+
+func (h *Handler) RegisterClusterFromConfig(
+	ctx context.Context,
+	request api.RegisterClusterFromConfigRequestObject,
+) (api.RegisterClusterFromConfigResponseObject, error) {
+	registerCluster := &flow.RegisterClusterFromConfig{ //nolint:exhaustruct
+		Actor: actor(request.Params.XCepherActor),
+		Now:   time.Now(),
+	}
+
+	switch {
+	case request.JSONBody != nil:
+		registerCluster.Name = request.JSONBody.Name
+		registerCluster.CephConf = request.JSONBody.CephConf
+		registerCluster.Keyring = request.JSONBody.Keyring
+
+		if request.JSONBody.Entity != nil {
+			registerCluster.Entity = *request.JSONBody.Entity
+		}
+
+		if request.JSONBody.Labels != nil {
+			registerCluster.Labels = *request.JSONBody.Labels
+		}
+	case request.MultipartBody != nil:
+		err := readConfigParts(request.MultipartBody, registerCluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if request.Params.Async != nil && *request.Params.Async {
+		job, err := h.service.StartRegisterClusterFromConfig(ctx, registerCluster)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		go h.runRegisterJob(job) //nolint:contextcheck
+
+		return api.RegisterClusterFromConfig202JSONResponse(newAPIJob(job)), nil
+	}
+
+	cluster, err := h.service.RegisterClusterFromConfig(ctx, registerCluster)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	h.addJob(cluster.ID) //nolint:contextcheck
+
+	return api.RegisterClusterFromConfig201JSONResponse(newAPICluster(cluster)), nil
+}
+
+// readConfigParts는 multipart의 name, ceph_conf, keyring, entity, labels part를 읽는다.
+// ceph.conf와 keyring은 작은 파일이므로 part마다 크기를 제한한다. labels는 JSON object이다.
+// 모르는 part는 잘못 보낸 것이므로 무시하지 않고 오류를 반환한다.
+func readConfigParts(reader *multipart.Reader, registerCluster *flow.RegisterClusterFromConfig) error {
+	const maxPartSize = 1 << 20
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w: failed to read multipart: %w", domain.InvalidParameterError("body"), err)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(part, maxPartSize+1))
+		if err != nil {
+			return fmt.Errorf("%w: failed to read part: %w", domain.InvalidParameterError(part.FormName()), err)
+		}
+
+		if len(data) > maxPartSize {
+			return fmt.Errorf("%w: larger than %d bytes", domain.InvalidParameterError(part.FormName()), maxPartSize)
+		}
+
+		switch part.FormName() {
+		case "name":
+			registerCluster.Name = strings.TrimSpace(string(data))
+		case "ceph_conf":
+			registerCluster.CephConf = string(data)
+		case "keyring":
+			registerCluster.Keyring = string(data)
+		case "entity":
+			registerCluster.Entity = strings.TrimSpace(string(data))
+		case "labels":
+			err = json.Unmarshal(data, &registerCluster.Labels)
+			if err != nil {
+				return fmt.Errorf("%w: %w", domain.InvalidParameterError("labels"), err)
+			}
+		default:
+			return fmt.Errorf("%w: unknown part", domain.InvalidParameterError(part.FormName()))
+		}
+	}
 }
 
 func (h *Handler) ListClusters(
 	ctx context.Context,
 	request api.ListClustersRequestObject,
 ) (api.ListClustersResponseObject, error) {
-	log.Println("ListClusters")
+	query := &flow.ClusterQuery{Now: time.Now()} //nolint:exhaustruct
+	if request.Params.Selector != nil {
+		query.Selector = *request.Params.Selector
+	}
+
+	if request.Params.Status != nil {
+		for _, status := range *request.Params.Status {
+			query.Statuses = append(query.Statuses, string(status))
+		}
+	}
 
-	clusters, err := h.service.ListClusters(ctx)
+	if request.Params.Name != nil {
+		query.Name = *request.Params.Name
+	}
+
+	if request.Params.Sort != nil {
+		query.Sort = string(*request.Params.Sort)
+	}
+
+	if request.Params.Offset != nil {
+		query.Offset = *request.Params.Offset
+	}
+
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+
+	clusters, total, err := h.service.SearchClusters(ctx, query)
 	if err != nil {
-		return api.ListClusters500JSONResponse{ //nolint:nilerr
-			Message: err.Error(),
-		}, nil
+		return nil, err //nolint:wrapcheck
 	}
 
 	if len(clusters) == 0 {
-		return api.ListClusters204Response{}, nil
+		return api.ListClusters204Response{
+			Headers: api.ListClusters204ResponseHeaders{
+				XTotalCount: total,
+			},
+		}, nil
 	}
 
 	var apiClusters []api.Cluster
 	for _, cluster := range clusters {
-		apiClusters = append(apiClusters, api.Cluster{
-			Id:       cluster.ID,
-			Name:     cluster.Name,
-			Status:   api.ClusterStatus(cluster.Status),
-			IsStable: cluster.IsStable,
-			Detail:   &cluster.Detail,
+		apiClusters = append(apiClusters, newAPICluster(cluster))
+	}
+
+	return api.ListClusters200JSONResponse{
+		Body: apiClusters,
+		Headers: api.ListClusters200ResponseHeaders{
+			XTotalCount: total,
+		},
+	}, nil
+}
+
+func (h *Handler) GetCluster(
+	ctx context.Context,
+	request api.GetClusterRequestObject,
+) (api.GetClusterResponseObject, error) {
+	cluster, err := h.service.GetCluster(ctx, request.Id, time.Now())
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return api.GetCluster200JSONResponse(newAPICluster(cluster)), nil
+}
+
+func (h *Handler) UpdateCluster(
+	ctx context.Context,
+	request api.UpdateClusterRequestObject,
+) (api.UpdateClusterResponseObject, error) {
+	updateCluster := &flow.UpdateCluster{ //nolint:exhaustruct
+		Actor: actor(request.Params.XCepherActor),
+		Now:   time.Now(),
+	}
+	if request.Body.Labels != nil {
+		updateCluster.Labels = *request.Body.Labels
+	}
+
+	cluster, err := h.service.UpdateCluster(ctx, request.Id, updateCluster)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return api.UpdateCluster200JSONResponse(newAPICluster(cluster)), nil
+}
+
+func (h *Handler) UpdateClusterHosts(
+	ctx context.Context,
+	request api.UpdateClusterHostsRequestObject,
+) (api.UpdateClusterHostsResponseObject, error) {
+	var hosts []string
+	if request.Body.Hosts != nil {
+		hosts = *request.Body.Hosts
+	}
+
+	cluster, err := h.service.UpdateHosts(ctx, request.Id, &flow.UpdateHosts{
+		Hosts:  hosts,
+		Pinned: request.Body.Pinned,
+		Actor:  actor(request.Params.XCepherActor),
+		Now:    time.Now(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return api.UpdateClusterHosts200JSONResponse(newAPICluster(cluster)), nil
+}
+
+func (h *Handler) GetClusterOverview(
+	ctx context.Context,
+	request api.GetClusterOverviewRequestObject,
+) (api.GetClusterOverviewResponseObject, error) {
+	overview, err := h.service.GetOverview(ctx, request.Id)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	// 필수 필드가 null로 직렬화되지 않도록 한다.
+	states := overview.PGStates
+	if states == nil {
+		states = map[string]int{}
+	}
+
+	quorum := overview.Quorum
+	if quorum == nil {
+		quorum = []string{}
+	}
+
+	return api.GetClusterOverview200JSONResponse{
+		CollectedAt: overview.CollectedTime,
+		Capacity: api.Capacity{
+			TotalBytes: overview.TotalBytes,
+			UsedBytes:  overview.UsedBytes,
+			AvailBytes: overview.AvailBytes,
+		},
+		Osds: api.OSDCount{
+			Total: overview.OSDs,
+			Up:    overview.UpOSDs,
+			In:    overview.InOSDs,
+		},
+		Pgs: api.PGCount{
+			Total:  overview.PGs,
+			States: states,
+		},
+		PoolCount: overview.PoolCount,
+		ClientIo: api.ClientIO{
+			ReadBytesPerSec:  overview.ReadBytesPerSec,
+			WriteBytesPerSec: overview.WriteBytesPerSec,
+			ReadOpsPerSec:    overview.ReadOpsPerSec,
+			WriteOpsPerSec:   overview.WriteOpsPerSec,
+		},
+		Quorum: quorum,
+	}, nil
+}
+
+func (h *Handler) ListClusterOsds(
+	ctx context.Context,
+	request api.ListClusterOsdsRequestObject,
+) (api.ListClusterOsdsResponseObject, error) {
+	osds, err := h.service.ListOSDs(ctx, request.Id)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	apiOSDs := []api.OSD{}
+	for _, osd := range osds {
+		apiOSDs = append(apiOSDs, api.OSD{
+			Id:          osd.ID,
+			Name:        osd.Name,
+			Host:        osd.Host,
+			DeviceClass: osd.DeviceClass,
+			Up:          osd.Up,
+			In:          osd.In,
+			CrushWeight: osd.CrushWeight,
+			Reweight:    osd.Reweight,
+			Utilization: osd.Utilization,
+			Pgs:         osd.PGs,
+		})
+	}
+
+	return api.ListClusterOsds200JSONResponse(apiOSDs), nil
+}
+
+func (h *Handler) GetClusterUsage(
+	ctx context.Context,
+	request api.GetClusterUsageRequestObject,
+) (api.GetClusterUsageResponseObject, error) {
+	const defaultPeriod = 30 * 24 * time.Hour
+
+	since := time.Now().Add(-defaultPeriod)
+	if request.Params.Since != nil {
+		since = *request.Params.Since
+	}
+
+	trend, err := h.service.GetUsageTrend(ctx, request.Id, since)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	pools := []api.UsageSeries{}
+	for _, pool := range trend.Pools {
+		pools = append(pools, newAPIUsageSeries(pool))
+	}
+
+	return api.GetClusterUsage200JSONResponse{
+		NearfullRatio: trend.NearFullRatio,
+		FullRatio:     trend.FullRatio,
+		Cluster:       newAPIUsageSeries(trend.Cluster),
+		Pools:         pools,
+	}, nil
+}
+
+func newAPIUsageSeries(series *flow.UsageSeries) api.UsageSeries {
+	var name *string
+	if series.Name != "" {
+		name = &series.Name
+	}
+
+	history := []api.UsagePoint{}
+	for _, point := range series.History {
+		history = append(history, api.UsagePoint{
+			Time:      point.Time,
+			UsedBytes: point.UsedBytes,
+			UsedRatio: point.UsedRatio,
+		})
+	}
+
+	return api.UsageSeries{
+		Name:     name,
+		History:  history,
+		Nearfull: newAPIForecast(series.NearFull),
+		Full:     newAPIForecast(series.Full),
+	}
+}
+
+func newAPIForecast(forecast *flow.Forecast) api.Forecast {
+	var predicted *time.Time
+	if !forecast.Time.IsZero() {
+		predicted = &forecast.Time
+	}
+
+	return api.Forecast{
+		Threshold: forecast.Threshold,
+		State:     api.ForecastState(forecast.State),
+		Time:      predicted,
+	}
+}
+
+func (h *Handler) ListClusterEvents(
+	ctx context.Context,
+	request api.ListClusterEventsRequestObject,
+) (api.ListClusterEventsResponseObject, error) {
+	events, err := h.service.ListEvents(ctx, request.Id)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	apiEvents := []api.Event{}
+	for _, event := range events {
+		apiEvents = append(apiEvents, api.Event{
+			Id:      event.ID,
+			Kind:    api.EventKind(event.Kind),
+			Message: event.Message,
+			Time:    event.Time,
 		})
 	}
 
-	return api.ListClusters200JSONResponse(apiClusters), nil
+	return api.ListClusterEvents200JSONResponse(apiEvents), nil
+}
+
+func newAPIMonitorMap(monitorMap *flow.MonitorMap) *api.MonitorMap {
+	if monitorMap == nil {
+		return nil
+	}
+
+	monitors := []api.Monitor{}
+
+	for _, monitor := range monitorMap.Monitors {
+		addrs := []api.MonitorAddress{}
+		for _, addr := range monitor.Addrs {
+			addrs = append(addrs, api.MonitorAddress{
+				Type: addr.Protocol,
+				Addr: addr.Addr,
+			})
+		}
+
+		monitors = append(monitors, api.Monitor{
+			Name:     monitor.Name,
+			Rank:     monitor.Rank,
+			Addrs:    addrs,
+			InQuorum: monitor.InQuorum,
+		})
+	}
+
+	return &api.MonitorMap{
+		Epoch:    monitorMap.Epoch,
+		Monitors: monitors,
+	}
+}
+
+func newAPIMonitorProbes(probes []*flow.MonitorProbe) *[]api.MonitorProbe {
+	if len(probes) == 0 {
+		return nil
+	}
+
+	ret := []api.MonitorProbe{}
+
+	for _, probe := range probes {
+		var lastError *string
+		if probe.LastError != "" {
+			lastError = &probe.LastError
+		}
+
+		ret = append(ret, api.MonitorProbe{
+			Address:   probe.Address,
+			Reachable: probe.Reachable,
+			LatencyMs: float64(probe.Latency) / float64(time.Millisecond),
+			LastError: lastError,
+			ProbedAt:  probe.ProbedTime,
+		})
+	}
+
+	return &ret
+}
+
+func newAPIVersions(versions *flow.Versions) *api.Versions {
+	if versions == nil {
+		return nil
+	}
+
+	daemons := []api.DaemonVersion{}
+	for _, daemon := range versions.Daemons {
+		daemons = append(daemons, api.DaemonVersion{
+			Daemon:  daemon.Daemon,
+			Version: daemon.Version,
+			Release: daemon.Release,
+			Count:   daemon.Count,
+		})
+	}
+
+	return &api.Versions{
+		Release: versions.Release,
+		Mixed:   versions.Mixed,
+		Daemons: daemons,
+	}
+}
+
+func newAPICluster(cluster *flow.Cluster) api.Cluster {
+	var lastContactTime *time.Time
+	if !cluster.LastContactTime.IsZero() {
+		lastContactTime = &cluster.LastContactTime
+	}
+
+	var lastError *string
+	if cluster.LastError != "" {
+		lastError = &cluster.LastError
+	}
+
+	var fsid *string
+	if cluster.Fsid != "" {
+		fsid = &cluster.Fsid
+	}
+
+	var fallbackHosts *[]string
+	if len(cluster.FallbackHosts) > 0 {
+		fallbackHosts = &cluster.FallbackHosts
+	}
+
+	var labels *api.Labels
+	if len(cluster.Labels) > 0 {
+		labels = (*api.Labels)(&cluster.Labels)
+	}
+
+	return api.Cluster{
+		Id:            cluster.ID,
+		Name:          cluster.Name,
+		Fsid:          fsid,
+		Hosts:         cluster.Hosts,
+		FallbackHosts: fallbackHosts,
+		HostsPinned:   cluster.HostsPinned,
+		Entity:        cluster.Entity,
+		Labels:        labels,
+		Status:        api.ClusterStatus(cluster.Status),
+		IsStable:      cluster.IsStable,
+		Detail:        &cluster.Detail,
+		Reachability: api.Reachability{
+			State:           api.ReachabilityState(cluster.Reachability),
+			LastError:       lastError,
+			LastContactTime: lastContactTime,
+		},
+		MonitorMap:    newAPIMonitorMap(cluster.MonitorMap),
+		MonitorProbes: newAPIMonitorProbes(cluster.Probes),
+		Versions:      newAPIVersions(cluster.Versions),
+	}
+}
+
+// jobContext는 scheduler가 한 번 실행할 때 쓰는 context와 span이다.
+// 이 context로 남기는 log에는 cluster id와 실행마다 다른 run id가 붙는다.
+func jobContext(clusterID string, job string) (context.Context, trace.Span) {
+	runID := uuid.NewString()
+
+	ctx := logging.WithAttrs(context.Background(),
+		slog.String("cluster_id", clusterID),
+		slog.String("job", job),
+		slog.String("run_id", runID),
+	)
+
+	return tracer.Start(ctx, "job "+job, trace.WithAttributes( //nolint:spancheck
+		attribute.String("cepher.cluster_id", clusterID),
+		attribute.String("cepher.run_id", runID),
+	))
 }
 
 func (h *Handler) refreshCluster(clusterID string) {
+	ctx, span := jobContext(clusterID, "refresh")
+	defer span.End()
+
 	now := time.Now()
-	log.Printf("refreshCluster: %s at %v", clusterID, now)
 
-	ok, err := h.service.RefreshCluster(context.Background(), clusterID, now)
+	h.logger.DebugContext(ctx, "refresh cluster")
+
+	ok, err := h.service.RefreshCluster(ctx, clusterID, now)
 	if err != nil {
-		log.Printf("failed to refresh cluster %s: %v", clusterID, err)
+		span.RecordError(err)
+		h.logger.ErrorContext(ctx, "failed to refresh cluster", "error", err)
 
 		return
 	}
 
+	span.SetAttributes(attribute.Bool("cepher.cluster_ok", ok))
+
+	h.jobSlidersMu.Lock()
+	defer h.jobSlidersMu.Unlock()
+
 	slider := h.jobSliders[clusterID]
 	if ok {
 		slider = slider.Down()
@@ -153,7 +707,9 @@ func (h *Handler) refreshCluster(clusterID string) {
 }
 
 func (h *Handler) afterJobRuns(jobID uuid.UUID, clusterID string) {
+	h.jobSlidersMu.Lock()
 	slider := h.jobSliders[clusterID]
+	h.jobSlidersMu.Unlock()
 
 	_, err := h.scheduler.NewJob(
 		gocron.OneTimeJob(gocron.OneTimeJobStartDateTime(time.Now().Add(h.levelDuration[slider.value]))),
@@ -164,29 +720,505 @@ func (h *Handler) afterJobRuns(jobID uuid.UUID, clusterID string) {
 		),
 	)
 	if err != nil {
-		log.Printf("failed to create job: %v", err)
+		h.logger.Error("failed to create job", "cluster_id", clusterID, "error", err)
+	}
+}
+
+// runRegisterJob은 등록 job을 background에서 진행하고, 성공하면 cluster를 주기적으로 확인하기 시작한다.
+func (h *Handler) runRegisterJob(job *flow.Job) {
+	ctx, span := jobContext(job.ClusterID, "register")
+	defer span.End()
+
+	ctx = logging.WithAttrs(ctx, slog.String("job_id", job.ID))
+	span.SetAttributes(attribute.String("cepher.job_id", job.ID))
+
+	result, err := h.service.RunRegisterJob(ctx, job.ID)
+	if err != nil {
+		span.RecordError(err)
+		h.logger.ErrorContext(ctx, "failed to run register job", "error", err)
+
+		return
+	}
+
+	span.SetAttributes(attribute.String("cepher.job_state", result.State))
+
+	if result.IsSucceeded() {
+		h.addJob(result.ClusterID)
+	}
+}
+
+func (h *Handler) collectUsage(clusterID string) {
+	ctx, span := jobContext(clusterID, "usage")
+	defer span.End()
+
+	err := h.service.CollectUsage(ctx, clusterID, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		h.logger.ErrorContext(ctx, "failed to collect usage", "error", err)
+	}
+}
+
+func (h *Handler) updateMonitor(clusterID string) {
+	ctx, span := jobContext(clusterID, "monitor")
+	defer span.End()
+
+	h.logger.DebugContext(ctx, "update monitor")
+
+	err := h.service.UpdateMonitor(ctx, clusterID, time.Now())
+	if err != nil {
+		span.RecordError(err)
+		h.logger.ErrorContext(ctx, "failed to update monitor", "error", err)
 	}
 }
 
 func (h *Handler) addJob(clusterID string) {
 	const maxValue = 2
 
+	h.jobSlidersMu.Lock()
 	h.jobSliders[clusterID] = NewSlider(0, maxValue, maxValue)
+	h.jobSlidersMu.Unlock()
 	h.afterJobRuns(uuid.Nil, clusterID)
 
 	_, err := h.scheduler.NewJob(
 		gocron.DailyJob(1, gocron.NewAtTimes(gocron.NewAtTime(0, 0, 0))),
-		gocron.NewTask(func() {
-			log.Printf("UpdateMonitor at %v", time.Now())
+		gocron.NewTask(h.updateMonitor, clusterID),
+		gocron.JobOption(gocron.WithStartImmediately()),
+	)
+	if err != nil {
+		h.logger.Error("failed to create job", "cluster_id", clusterID, "error", err)
+	}
 
-			err := h.service.UpdateMonitor(context.Background(), clusterID)
-			if err != nil {
-				log.Printf("failed to update monitor %s: %v", clusterID, err)
-			}
-		}),
+	const usageInterval = time.Hour
+
+	_, err = h.scheduler.NewJob(
+		gocron.DurationJob(usageInterval),
+		gocron.NewTask(h.collectUsage, clusterID),
 		gocron.JobOption(gocron.WithStartImmediately()),
 	)
 	if err != nil {
-		log.Printf("failed to create job: %v", err)
+		h.logger.Error("failed to create job", "cluster_id", clusterID, "error", err)
+	}
+}
+
+func (h *Handler) GetClientPool(
+	ctx context.Context,
+	request api.GetClientPoolRequestObject,
+) (api.GetClientPoolResponseObject, error) {
+	stats := h.service.GetClientPoolStats()
+
+	return api.GetClientPool200JSONResponse{
+		Sessions:      stats.Sessions,
+		Hits:          int64(stats.Hits),          //nolint:gosec
+		Misses:        int64(stats.Misses),        //nolint:gosec
+		Invalidations: int64(stats.Invalidations), //nolint:gosec
+		Evictions:     int64(stats.Evictions),     //nolint:gosec
+	}, nil
+}
+
+func (h *Handler) GetSummary(
+	ctx context.Context,
+	request api.GetSummaryRequestObject,
+) (api.GetSummaryResponseObject, error) {
+	query := &flow.SummaryQuery{ //nolint:exhaustruct
+		Now: time.Now(),
+	}
+	if request.Params.GroupBy != nil {
+		query.GroupBy = *request.Params.GroupBy
+	}
+
+	if request.Params.Top != nil {
+		query.TopChecks = *request.Params.Top
+	}
+
+	summary, err := h.service.GetSummary(ctx, query)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	checks := []api.HealthCheckCount{}
+	for _, check := range summary.Checks {
+		checks = append(checks, api.HealthCheckCount{
+			Code:       check.Code,
+			Severity:   check.Severity,
+			Count:      check.Count,
+			ClusterIds: check.ClusterIDs,
+		})
+	}
+
+	var groups *[]api.SummaryGroup
+
+	if summary.Groups != nil {
+		apiGroups := []api.SummaryGroup{}
+
+		for _, group := range summary.Groups {
+			var value *string
+			if group.HasValue {
+				value = &group.Value
+			}
+
+			apiGroups = append(apiGroups, api.SummaryGroup{
+				Value:    value,
+				Total:    group.Total,
+				Statuses: group.Statuses,
+				Stable:   group.Stable,
+			})
+		}
+
+		groups = &apiGroups
+	}
+
+	return api.GetSummary200JSONResponse{
+		Total:          summary.Total,
+		Statuses:       summary.Statuses,
+		Stable:         summary.Stable,
+		Reachabilities: summary.Reachabilities,
+		TopChecks:      checks,
+		Groups:         groups,
+		GeneratedAt:    summary.GeneratedTime,
+	}, nil
+}
+
+func (h *Handler) ListCommands(
+	ctx context.Context,
+	request api.ListCommandsRequestObject,
+) (api.ListCommandsResponseObject, error) {
+	specs := []api.CommandSpec{}
+	for _, spec := range h.service.ListCommands() {
+		specs = append(specs, api.CommandSpec{
+			Prefix:         spec.Prefix,
+			MaxArgs:        spec.MaxArgs,
+			TimeoutSeconds: spec.Timeout.Seconds(),
+		})
+	}
+
+	return api.ListCommands200JSONResponse(specs), nil
+}
+
+func (h *Handler) RunClusterCommand(
+	ctx context.Context,
+	request api.RunClusterCommandRequestObject,
+) (api.RunClusterCommandResponseObject, error) {
+	result, err := h.service.RunCommand(ctx, request.Id, &flow.RunCommand{
+		Args:  request.Body.Args,
+		Actor: actor(request.Params.XCepherActor),
+		Now:   time.Now(),
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return api.RunClusterCommand200JSONResponse{
+		Command:    result.Command,
+		Output:     result.Output,
+		DurationMs: float64(result.Duration) / float64(time.Millisecond),
+	}, nil
+}
+
+// actor는 X-Cepher-Actor 헤더의 값이다. 없으면 anonymous로 남긴다.
+func actor(header *api.Actor) string {
+	if header == nil || *header == "" {
+		return "anonymous"
+	}
+
+	return *header
+}
+
+func (h *Handler) ListAuditEntries(
+	ctx context.Context,
+	request api.ListAuditEntriesRequestObject,
+) (api.ListAuditEntriesResponseObject, error) {
+	query := &flow.AuditQuery{} //nolint:exhaustruct
+	if request.Params.ClusterId != nil {
+		query.ClusterID = *request.Params.ClusterId
+	}
+
+	if request.Params.Actor != nil {
+		query.Actor = *request.Params.Actor
+	}
+
+	if request.Params.Action != nil {
+		query.Action = string(*request.Params.Action)
+	}
+
+	if request.Params.Since != nil {
+		query.Since = *request.Params.Since
+	}
+
+	if request.Params.Until != nil {
+		query.Until = *request.Params.Until
+	}
+
+	if request.Params.Limit != nil {
+		query.Limit = *request.Params.Limit
+	}
+
+	entries, err := h.service.ListAuditEntries(ctx, query)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	apiEntries := []api.AuditEntry{}
+
+	for _, entry := range entries {
+		changes := []api.FieldChange{}
+		for _, change := range entry.Changes {
+			changes = append(changes, api.FieldChange{
+				Field:  change.Field,
+				Before: change.Before,
+				After:  change.After,
+			})
+		}
+
+		apiEntries = append(apiEntries, api.AuditEntry{
+			Id:        entry.ID,
+			Time:      entry.Time,
+			Actor:     entry.Actor,
+			ClusterId: entry.ClusterID,
+			Action:    api.AuditAction(entry.Action),
+			Detail:    entry.Detail,
+			Changes:   changes,
+		})
+	}
+
+	return api.ListAuditEntries200JSONResponse(apiEntries), nil
+}
+
+func (h *Handler) ExportClusters(
+	ctx context.Context,
+	request api.ExportClustersRequestObject,
+) (api.ExportClustersResponseObject, error) {
+	exportClusters := &flow.ExportClusters{ //nolint:exhaustruct
+		Now: time.Now(),
+	}
+	if request.Params.KeyMode != nil {
+		exportClusters.KeyMode = string(*request.Params.KeyMode)
+	}
+
+	if request.Params.XCepherPassphrase != nil {
+		exportClusters.Passphrase = *request.Params.XCepherPassphrase
+	}
+
+	document, err := h.service.ExportClusters(ctx, exportClusters)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return api.ExportClusters200JSONResponse(newAPIExportDocument(document)), nil
+}
+
+func newAPIExportDocument(document *flow.ExportDocument) api.ExportDocument {
+	var encryption *api.KeyEncryption
+	if document.Encryption != nil {
+		encryption = &api.KeyEncryption{
+			Algorithm:  document.Encryption.Algorithm,
+			Kdf:        document.Encryption.KDF,
+			Iterations: document.Encryption.Iterations,
+			Salt:       document.Encryption.Salt,
+		}
+	}
+
+	clusters := []api.ExportedCluster{}
+
+	for _, cluster := range document.Clusters {
+		var fsid, key *string
+		if cluster.Fsid != "" {
+			fsid = &cluster.Fsid
+		}
+
+		if cluster.Key != "" {
+			key = &cluster.Key
+		}
+
+		var fallbackHosts *[]string
+		if len(cluster.FallbackHosts) > 0 {
+			fallbackHosts = &cluster.FallbackHosts
+		}
+
+		var labels *api.Labels
+		if cluster.Labels != nil {
+			labels = (*api.Labels)(&cluster.Labels)
+		}
+
+		clusters = append(clusters, api.ExportedCluster{
+			Id:            &cluster.ID,
+			Name:          cluster.Name,
+			Fsid:          fsid,
+			Hosts:         cluster.Hosts,
+			FallbackHosts: fallbackHosts,
+			HostsPinned:   &cluster.HostsPinned,
+			Entity:        &cluster.Entity,
+			Key:           key,
+			Labels:        labels,
+		})
+	}
+
+	return api.ExportDocument{
+		Version:    document.Version,
+		ExportedAt: document.ExportedTime,
+		KeyMode:    api.KeyMode(document.KeyMode),
+		Encryption: encryption,
+		Clusters:   clusters,
+	}
+}
+
+func newFlowExportDocument(document *api.ExportDocument) *flow.ExportDocument {
+	var encryption *flow.KeyEncryption
+	if document.Encryption != nil {
+		encryption = &flow.KeyEncryption{
+			Algorithm:  document.Encryption.Algorithm,
+			KDF:        document.Encryption.Kdf,
+			Iterations: document.Encryption.Iterations,
+			Salt:       document.Encryption.Salt,
+		}
+	}
+
+	var clusters []*flow.ExportedCluster
+
+	for _, cluster := range document.Clusters {
+		exported := &flow.ExportedCluster{ //nolint:exhaustruct
+			Name:  cluster.Name,
+			Hosts: cluster.Hosts,
+		}
+		if cluster.Id != nil {
+			exported.ID = *cluster.Id
+		}
+
+		if cluster.Fsid != nil {
+			exported.Fsid = *cluster.Fsid
+		}
+
+		if cluster.FallbackHosts != nil {
+			exported.FallbackHosts = *cluster.FallbackHosts
+		}
+
+		if cluster.HostsPinned != nil {
+			exported.HostsPinned = *cluster.HostsPinned
+		}
+
+		if cluster.Entity != nil {
+			exported.Entity = *cluster.Entity
+		}
+
+		if cluster.Key != nil {
+			exported.Key = *cluster.Key
+		}
+
+		if cluster.Labels != nil {
+			exported.Labels = *cluster.Labels
+		}
+
+		clusters = append(clusters, exported)
+	}
+
+	return &flow.ExportDocument{
+		Version:      document.Version,
+		ExportedTime: document.ExportedAt,
+		KeyMode:      string(document.KeyMode),
+		Encryption:   encryption,
+		Clusters:     clusters,
+	}
+}
+
+func (h *Handler) ImportClusters(
+	ctx context.Context,
+	request api.ImportClustersRequestObject,
+) (api.ImportClustersResponseObject, error) {
+	importClusters := &flow.ImportClusters{ //nolint:exhaustruct
+		Document: newFlowExportDocument(request.Body),
+		Actor:    actor(request.Params.XCepherActor),
+		Now:      time.Now(),
+	}
+	if request.Params.DryRun != nil {
+		importClusters.DryRun = *request.Params.DryRun
+	}
+
+	if request.Params.OnConflict != nil {
+		importClusters.OnConflict = string(*request.Params.OnConflict)
+	}
+
+	if request.Params.XCepherPassphrase != nil {
+		importClusters.Passphrase = *request.Params.XCepherPassphrase
+	}
+
+	report, err := h.service.ImportClusters(ctx, importClusters)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	results := []api.ImportResult{}
+
+	for _, result := range report.Results {
+		if !report.DryRun && result.Action == flow.ImportActionCreated {
+			h.addJob(result.ClusterID) //nolint:contextcheck
+		}
+
+		apiResult := api.ImportResult{ //nolint:exhaustruct
+			Name:   result.Name,
+			Action: api.ImportResultAction(result.Action),
+		}
+		if result.Fsid != "" {
+			apiResult.Fsid = &result.Fsid
+		}
+
+		if result.ClusterID != "" {
+			apiResult.ClusterId = &result.ClusterID
+		}
+
+		if result.Message != "" {
+			apiResult.Message = &result.Message
+		}
+
+		results = append(results, apiResult)
+	}
+
+	return api.ImportClusters200JSONResponse{
+		DryRun:  report.DryRun,
+		Results: results,
+	}, nil
+}
+
+func (h *Handler) GetJob(
+	ctx context.Context,
+	request api.GetJobRequestObject,
+) (api.GetJobResponseObject, error) {
+	job, err := h.service.GetJob(ctx, request.Id)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return api.GetJob200JSONResponse(newAPIJob(job)), nil
+}
+
+func newAPIJob(job *flow.Job) api.Job {
+	steps := []api.JobStep{}
+
+	for _, step := range job.Steps {
+		apiStep := api.JobStep{
+			Name:       step.Name,
+			State:      api.JobState(step.State),
+			Message:    nil,
+			StartedAt:  step.StartTime,
+			FinishedAt: nil,
+		}
+
+		if step.Message != "" {
+			apiStep.Message = &step.Message
+		}
+
+		if !step.FinishTime.IsZero() {
+			apiStep.FinishedAt = &step.FinishTime
+		}
+
+		steps = append(steps, apiStep)
+	}
+
+	return api.Job{
+		Id:        job.ID,
+		Kind:      api.JobKind(job.Kind),
+		ClusterId: job.ClusterID,
+		State:     api.JobState(job.State),
+		Steps:     steps,
+		CreatedAt: job.CreatedTime,
+		UpdatedAt: job.UpdatedTime,
 	}
 }
@@ -4,7 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -15,7 +15,12 @@ import (
 	"github.com/neatflowcv/cepher/internal/app/flow"
 	"github.com/neatflowcv/cepher/internal/pkg/client/core"
 	"github.com/neatflowcv/cepher/internal/pkg/idgenerator/ulid"
+	"github.com/neatflowcv/cepher/internal/pkg/logging"
+	"github.com/neatflowcv/cepher/internal/pkg/prober/tcp"
 	"github.com/neatflowcv/cepher/internal/pkg/repository/file"
+	"github.com/neatflowcv/cepher/internal/pkg/repository/traced"
+	"github.com/neatflowcv/cepher/internal/pkg/tracing"
+	"github.com/neatflowcv/cepher/pkg/cephcli"
 	"github.com/neatflowcv/cepher/pkg/cephrest"
 )
 
@@ -29,34 +34,38 @@ func version() string {
 }
 
 func Main2() {
-	log.Println("version", version())
+	slog.Info("starting", "version", version())
 
 	apiURL := os.Getenv("CEPH_API_URL")
 	username := os.Getenv("CEPH_USERNAME")
 	password := os.Getenv("CEPH_PASSWORD")
 
 	if apiURL == "" || username == "" || password == "" {
-		log.Fatalf("CEPH_API_URL, CEPH_USERNAME, and CEPH_PASSWORD must be set")
+		slog.Error("CEPH_API_URL, CEPH_USERNAME, and CEPH_PASSWORD must be set")
+		os.Exit(1)
 	}
 
 	client := cephrest.NewClient(apiURL, username, password)
 
 	authResponse, err := client.Auth(context.Background())
 	if err != nil {
-		log.Fatalf("failed to authenticate with ceph: %v", err)
+		slog.Error("failed to authenticate with ceph", "error", err)
+		os.Exit(1)
 	}
 
 	err = client.GetHealthFull(context.Background(), authResponse.Token)
 	if err != nil {
-		log.Fatalf("failed to get cluster from ceph: %v", err)
+		slog.Error("failed to get cluster from ceph", "error", err)
+		os.Exit(1)
 	}
 
 	err = client.Logout(context.Background(), authResponse.Token)
 	if err != nil {
-		log.Fatalf("failed to logout from ceph: %v", err)
+		slog.Error("failed to logout from ceph", "error", err)
+		os.Exit(1)
 	}
 
-	log.Println("logged out from ceph")
+	slog.Info("logged out from ceph")
 }
 
 type CephCLIConfig struct {
@@ -85,8 +94,70 @@ func LoadCephCLIConfig() (*CephCLIConfig, error) {
 	}, nil
 }
 
+var (
+	ErrUnknownRuntime = errors.New("unknown runtime")
+)
+
+// LoadRunner는 CEPHER_RUNTIME에 따라 ceph 커맨드를 실행할 방법을 고른다.
+// podman(기본값), docker, nerdctl은 CEPHER_CEPH_IMAGE 이미지를 쓰고,
+// binary는 CEPHER_CEPH_BINARY(기본값 ceph)를 직접 실행한다.
+func LoadRunner() (cephcli.Runner, error) {
+	image := os.Getenv("CEPHER_CEPH_IMAGE")
+	if image == "" {
+		image = cephcli.DefaultImage
+	}
+
+	runtime := os.Getenv("CEPHER_RUNTIME")
+
+	switch runtime {
+	case "", "podman", "docker", "nerdctl":
+		if runtime == "" {
+			runtime = "podman"
+		}
+
+		return cephcli.NewContainerRunner(runtime, image), nil
+	case "binary":
+		binary := os.Getenv("CEPHER_CEPH_BINARY")
+		if binary == "" {
+			binary = "ceph"
+		}
+
+		return cephcli.NewBinaryRunner(binary), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownRuntime, runtime)
+	}
+}
+
+// LoadLogger는 CEPHER_LOG_FORMAT(text 또는 json)과 CEPHER_LOG_LEVEL(debug, info, warn, error)로 logger를 만든다.
+func LoadLogger() (*slog.Logger, error) {
+	logger, err := logging.New(os.Stderr, os.Getenv("CEPHER_LOG_FORMAT"), os.Getenv("CEPHER_LOG_LEVEL"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	return logger, nil
+}
+
 func main() {
-	log.Println("version", version())
+	logger, err := LoadLogger()
+	if err != nil {
+		slog.Error("failed to load logger", "error", err)
+		os.Exit(1)
+	}
+
+	slog.SetDefault(logger)
+
+	err = run(logger)
+	if err != nil {
+		logger.Error("failed to run cepher", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run은 server가 멈출 때까지 실행한다.
+// os.Exit는 defer를 건너뛰므로 tracing 등을 정리할 수 있도록 종료는 main에 맡기고 오류만 반환한다.
+func run(logger *slog.Logger) error {
+	logger.Info("starting cepher", "version", version())
 
 	const (
 		timeout = 10 * time.Second
@@ -94,7 +165,7 @@ func main() {
 
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		log.Panicf("failed to get home directory: %v", err)
+		return fmt.Errorf("failed to get home directory: %w", err)
 	}
 
 	storagePath := filepath.Join(homeDir, ".local/share/cepher")
@@ -103,15 +174,36 @@ func main() {
 
 	err = os.MkdirAll(storagePath, permission)
 	if err != nil {
-		log.Fatalf("failed to create storage directory: %v", err)
+		return fmt.Errorf("failed to create storage directory %s: %w", storagePath, err)
 	}
 
-	repository := file.NewRepository(storagePath)
-	service := flow.NewService(ulid.NewGenerator(), core.NewFactory(), repository)
+	shutdownTracing, err := tracing.Setup(context.Background(), os.Getenv("CEPHER_OTLP_ENDPOINT"), version())
+	if err != nil {
+		return fmt.Errorf("failed to setup tracing: %w", err)
+	}
 
-	handler, err := NewHandler(service)
+	defer func() {
+		err := shutdownTracing(context.Background())
+		if err != nil {
+			logger.Error("failed to shutdown tracing", "error", err)
+		}
+	}()
+
+	repository := traced.NewRepository(file.NewRepository(storagePath, logger))
+
+	runner, err := LoadRunner()
+	if err != nil {
+		return fmt.Errorf("failed to load ceph runner: %w", err)
+	}
+
+	factory := core.NewFactory(runner, logger)
+	defer factory.Close()
+
+	service := flow.NewService(ulid.NewGenerator(), factory, repository, tcp.NewProber(logger), logger)
+
+	handler, err := NewHandler(service, logger)
 	if err != nil {
-		log.Panicf("failed to create handler: %v", err)
+		return fmt.Errorf("failed to create handler: %w", err)
 	}
 	defer handler.Close()
 
@@ -123,6 +215,8 @@ func main() {
 
 	err = server.ListenAndServe()
 	if err != nil {
-		log.Panicf("failed to start server: %v", err)
+		return fmt.Errorf("failed to start server: %w", err)
 	}
+
+	return nil
 }
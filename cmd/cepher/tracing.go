@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/neatflowcv/cepher/api"
+	"github.com/neatflowcv/cepher/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/neatflowcv/cepher/cmd/cepher") //nolint:gochecknoglobals
+
+// traceOperation은 API operation마다 span을 남긴다. 요청에 trace context가 있으면 이어서 남긴다.
+func traceOperation(next api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (_ any, err error) {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+
+		ctx, span := tracer.Start(ctx, operationID,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("url.path", r.URL.Path),
+			),
+		)
+		defer func() { tracing.End(span, err) }()
+
+		return next(ctx, w, r, request)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/neatflowcv/cepher/api"
+)
+
+// Availability는 기간 동안의 cluster별 가용성을 출력한다. -csv를 주면 서버가 만든 CSV를 그대로 출력한다.
+func (c *Client) Availability(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("availability", flag.ContinueOnError)
+	cluster := flags.String("cluster", "", "이 cluster만 계산한다")
+	window := flags.Duration("window", 0, "until에서 이만큼 이전부터 계산한다 (예 720h). 0이면 서버 기본값(30일)")
+	since := flags.String("since", "", "기간의 시작 (RFC 3339). -window보다 우선한다")
+	until := flags.String("until", "", "기간의 끝 (RFC 3339, 기본값 현재 시각)")
+	asCSV := flags.Bool("csv", false, "CSV로 출력한다")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if flags.NArg() != 0 {
+		return fmt.Errorf("%w: availability takes no arguments", ErrUsage)
+	}
+
+	params := &api.GetAvailabilityParams{} //nolint:exhaustruct
+	if *cluster != "" {
+		params.ClusterId = cluster
+	}
+
+	end := time.Now()
+
+	if *until != "" {
+		end, err = time.Parse(time.RFC3339, *until)
+		if err != nil {
+			return fmt.Errorf("%w: invalid -until: %w", ErrUsage, err)
+		}
+
+		params.Until = &end
+	}
+
+	switch {
+	case *since != "":
+		start, err := time.Parse(time.RFC3339, *since)
+		if err != nil {
+			return fmt.Errorf("%w: invalid -since: %w", ErrUsage, err)
+		}
+
+		params.Since = &start
+	case *window != 0:
+		start := end.Add(-*window)
+		params.Since = &start
+	}
+
+	if *asCSV {
+		format := api.Csv
+		params.Format = &format
+	}
+
+	resp, err := c.api.GetAvailabilityWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to get availability: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	if *asCSV {
+		_, err = printer.writer.Write(resp.Body)
+		if err != nil {
+			return fmt.Errorf("failed to write csv: %w", err)
+		}
+
+		return nil
+	}
+
+	if resp.JSON200 == nil {
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	return printer.PrintAvailability(*resp.JSON200)
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/cepher/api"
+)
+
+var ErrUnexpectedResponse = errors.New("unexpected response")
+
+// Client는 cepherctl의 명령을 API 호출로 옮긴다.
+type Client struct {
+	api   *api.ClientWithResponses
+	actor string
+}
+
+func NewClient(config *Config) (*Client, error) {
+	client, err := api.NewClientWithResponses(
+		config.Server,
+		api.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			if config.Token != "" {
+				req.Header.Set("Authorization", "Bearer "+config.Token)
+			}
+
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+
+	return &Client{
+		api:   client,
+		actor: config.Actor,
+	}, nil
+}
+
+func (c *Client) Register(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("register", flag.ContinueOnError)
+	name := flags.String("name", "", "cluster 이름")
+	hosts := flags.String("hosts", "", "monitor 주소 목록 (쉼표로 구분)")
+	key := flags.String("key", "", "client.admin key")
+	keyFile := flags.String("key-file", "", "client.admin key가 담긴 파일")
+	entity := flags.String("entity", "", "접속할 ceph 사용자 (기본값 client.admin)")
+	conf := flags.String("conf", "", "ceph.conf 경로. 지정하면 -hosts 대신 mon_host를 읽고 -keyring의 key를 쓴다")
+	keyring := flags.String("keyring", "", "-conf와 함께 쓸 keyring 경로")
+	async := flags.Bool("async", false, "접속 확인을 기다리지 않고 등록 job을 출력한다. 결과는 job 명령으로 확인한다")
+	labels := labelFlag{}
+	flags.Var(labels, "label", "cluster에 붙일 label. key=value 형태이며 여러 번 쓸 수 있다")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if *conf != "" {
+		return c.registerFromConfig(ctx, printer, *name, *conf, *keyring, *entity, labels, *async)
+	}
+
+	if *keyFile != "" {
+		data, err := os.ReadFile(*keyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read key file: %w", err)
+		}
+
+		*key = strings.TrimSpace(string(data))
+	}
+
+	if *name == "" || *hosts == "" || *key == "" {
+		flags.Usage()
+
+		return fmt.Errorf("%w: -name, -hosts and -key (or -key-file), or -name, -conf and -keyring are required", ErrUsage)
+	}
+
+	body := api.RegisterCluster{ //nolint:exhaustruct
+		Name:  *name,
+		Hosts: strings.Split(*hosts, ","),
+		Key:   *key,
+	}
+	if *entity != "" {
+		body.Entity = entity
+	}
+
+	if len(labels) > 0 {
+		body.Labels = (*api.Labels)(&labels)
+	}
+
+	params := &api.RegisterClusterParams{} //nolint:exhaustruct
+	if c.actor != "" {
+		params.XCepherActor = &c.actor
+	}
+
+	if *async {
+		params.Async = async
+	}
+
+	resp, err := c.api.RegisterClusterWithResponse(ctx, params, body)
+	if err != nil {
+		return fmt.Errorf("failed to register cluster: %w", err)
+	}
+
+	switch {
+	case resp.JSON201 != nil:
+		return printer.PrintCluster(resp.JSON201)
+	case resp.JSON202 != nil:
+		return printer.PrintJob(resp.JSON202)
+	default:
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+}
+
+// registerFromConfig는 ceph.conf와 keyring 파일을 그대로 보내서 등록한다. 파일은 서버가 해석한다.
+func (c *Client) registerFromConfig(
+	ctx context.Context,
+	printer *Printer,
+	name, conf, keyring, entity string,
+	labels labelFlag,
+	async bool,
+) error {
+	if name == "" || keyring == "" {
+		return fmt.Errorf("%w: -name and -keyring are required with -conf", ErrUsage)
+	}
+
+	confData, err := os.ReadFile(conf)
+	if err != nil {
+		return fmt.Errorf("failed to read ceph.conf: %w", err)
+	}
+
+	keyringData, err := os.ReadFile(keyring)
+	if err != nil {
+		return fmt.Errorf("failed to read keyring: %w", err)
+	}
+
+	body := api.RegisterClusterFromConfig{ //nolint:exhaustruct
+		Name:     name,
+		CephConf: string(confData),
+		Keyring:  string(keyringData),
+	}
+	if entity != "" {
+		body.Entity = &entity
+	}
+
+	if len(labels) > 0 {
+		body.Labels = (*api.Labels)(&labels)
+	}
+
+	params := &api.RegisterClusterFromConfigParams{} //nolint:exhaustruct
+	if c.actor != "" {
+		params.XCepherActor = &c.actor
+	}
+
+	if async {
+		params.Async = &async
+	}
+
+	resp, err := c.api.RegisterClusterFromConfigWithResponse(ctx, params, body)
+	if err != nil {
+		return fmt.Errorf("failed to register cluster: %w", err)
+	}
+
+	switch {
+	case resp.JSON201 != nil:
+		return printer.PrintCluster(resp.JSON201)
+	case resp.JSON202 != nil:
+		return printer.PrintJob(resp.JSON202)
+	default:
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+}
+
+func (c *Client) List(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	selector := flags.String("l", "", `label 조건. 예 "env=prod,team in (a,b),!deprecated"`)
+	status := flags.String("status", "", "이 status인 cluster만 보여준다 (쉼표로 구분)")
+	name := flags.String("name", "", "이름에 이 문자열이 들어 있는 cluster만 보여준다")
+	sort := flags.String("sort", "", "정렬 기준: id, name, status, last_contact. 앞에 -를 붙이면 역순이다")
+	offset := flags.Int("offset", 0, "앞에서 건너뛸 개수")
+	limit := flags.Int("limit", 0, "보여줄 최대 개수 (0이면 모두)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if flags.NArg() != 0 {
+		return fmt.Errorf("%w: list takes no arguments", ErrUsage)
+	}
+
+	params := &api.ListClustersParams{} //nolint:exhaustruct
+	if *selector != "" {
+		params.Selector = selector
+	}
+
+	if *status != "" {
+		var statuses []api.ClusterStatus
+		for value := range strings.SplitSeq(*status, ",") {
+			statuses = append(statuses, api.ClusterStatus(value))
+		}
+
+		params.Status = &statuses
+	}
+
+	if *name != "" {
+		params.Name = name
+	}
+
+	if *sort != "" {
+		params.Sort = (*api.ListClustersParamsSort)(sort)
+	}
+
+	if *offset != 0 {
+		params.Offset = offset
+	}
+
+	if *limit != 0 {
+		params.Limit = limit
+	}
+
+	clusters, total, err := c.listClusters(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	err = printer.PrintClusters(clusters)
+	if err != nil {
+		return err
+	}
+
+	// 일부만 보여줄 때는 전체 개수를 알려 준다. json, yaml 출력은 그대로 둔다.
+	if printer.format == "table" && total > len(clusters) {
+		_, _ = fmt.Fprintf(printer.writer, "\nshowing %d of %d clusters\n", len(clusters), total)
+	}
+
+	return nil
+}
+
+// listClusters는 조건에 맞는 cluster와 offset, limit을 적용하기 전의 개수를 반환한다.
+func (c *Client) listClusters(ctx context.Context, params *api.ListClustersParams) ([]api.Cluster, int, error) {
+	resp, err := c.api.ListClustersWithResponse(ctx, params)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	clusters := []api.Cluster{}
+
+	switch {
+	case resp.JSON200 != nil:
+		clusters = *resp.JSON200
+	case resp.StatusCode() == http.StatusNoContent:
+	default:
+		return nil, 0, unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	total, err := strconv.Atoi(resp.HTTPResponse.Header.Get("X-Total-Count"))
+	if err != nil {
+		total = len(clusters)
+	}
+
+	return clusters, total, nil
+}
+
+func (c *Client) Show(ctx context.Context, printer *Printer, args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("%w: show takes a cluster id", ErrUsage)
+	}
+
+	cluster, err := c.getCluster(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	return printer.PrintCluster(cluster)
+}
+
+func (c *Client) getCluster(ctx context.Context, id string) (*api.Cluster, error) {
+	resp, err := c.api.GetClusterWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+// Watch는 interval마다 화면을 지우고 list 또는 show를 다시 출력한다. Ctrl-C로 멈춘다.
+func (c *Client) Watch(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("watch", flag.ContinueOnError)
+	interval := flags.Duration("interval", 5*time.Second, "다시 불러오는 주기") //nolint:mnd
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if flags.NArg() > 1 {
+		return fmt.Errorf("%w: watch takes at most one cluster id", ErrUsage)
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		// json, yaml은 다른 도구로 이어 받을 수 있도록 결과만 이어서 출력한다.
+		if printer.format == "table" {
+			if printer.terminal {
+				_, _ = fmt.Fprint(printer.writer, "\033[H\033[2J")
+			}
+
+			_, _ = fmt.Fprintf(printer.writer, "Every %v: %s\n\n", *interval, time.Now().Format(time.DateTime))
+		}
+
+		if flags.NArg() == 1 {
+			err = c.Show(ctx, printer, flags.Args())
+		} else {
+			err = c.List(ctx, printer, nil)
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			_, _ = fmt.Fprintln(printer.writer, "error:", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// unexpected는 실패 응답을 오류로 바꾼다. 서버가 Error 형식으로 응답하면 code와 field를 함께 보여준다.
+func unexpected(resp *http.Response, body []byte) error {
+	message := strings.TrimSpace(string(body))
+	if message == "" {
+		message = http.StatusText(resp.StatusCode)
+	}
+
+	var apiError api.Error
+
+	err := json.Unmarshal(body, &apiError)
+	if err == nil && apiError.Code != "" {
+		message = fmt.Sprintf("%s: %s", apiError.Code, apiError.Message)
+		if apiError.Field != nil {
+			message = fmt.Sprintf("%s (field %s): %s", apiError.Code, *apiError.Field, apiError.Message)
+		}
+	}
+
+	return fmt.Errorf("%w: %s: %s", ErrUnexpectedResponse, resp.Status, message)
+}
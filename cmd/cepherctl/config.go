@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const defaultServer = "http://localhost:8080"
+
+// Config는 cepherctl 설정 파일의 내용이다.
+//
+//	server: http://cepher.example.com:8080
+//	token: secret
+//	output: table
+type Config struct {
+	Server string `yaml:"server"`
+	Token  string `yaml:"token"`
+	Output string `yaml:"output"`
+	// Actor는 audit log에 남길 이름이다. 비어 있으면 로그인 사용자 이름을 쓴다.
+	Actor string `yaml:"actor"`
+}
+
+// defaultConfigPath는 $XDG_CONFIG_HOME/cepher/cepherctl.yaml(없으면 ~/.config/cepher/cepherctl.yaml)이다.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "cepher", "cepherctl.yaml")
+}
+
+// LoadConfig는 path의 설정 파일을 읽는다. 파일이 없으면 기본값을 쓴다.
+// CEPHER_SERVER, CEPHER_TOKEN 환경 변수가 있으면 파일의 값보다 우선한다.
+func LoadConfig(path string) (*Config, error) {
+	config := &Config{ //nolint:exhaustruct
+		Server: defaultServer,
+		Output: "table",
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path) //nolint:gosec
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read config: %w", err)
+		}
+
+		if err == nil {
+			err = yaml.Unmarshal(data, config)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+			}
+		}
+	}
+
+	server := os.Getenv("CEPHER_SERVER")
+	if server != "" {
+		config.Server = server
+	}
+
+	token := os.Getenv("CEPHER_TOKEN")
+	if token != "" {
+		config.Token = token
+	}
+
+	if config.Actor == "" {
+		config.Actor = os.Getenv("USER")
+	}
+
+	return config, nil
+}
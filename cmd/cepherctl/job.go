@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/api"
+)
+
+// Job은 job의 단계별 결과를 출력한다. -wait를 주면 job이 끝날 때까지 기다린 뒤 출력한다.
+func (c *Client) Job(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("job", flag.ContinueOnError)
+	wait := flags.Bool("wait", false, "job이 끝날 때까지 기다린다")
+	interval := flags.Duration("interval", time.Second, "-wait일 때 다시 확인하는 주기")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: job takes a job id", ErrUsage)
+	}
+
+	job, err := c.getJob(ctx, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for *wait && !isJobFinished(job) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err() //nolint:wrapcheck
+		case <-ticker.C:
+		}
+
+		job, err = c.getJob(ctx, job.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return printer.PrintJob(job)
+}
+
+func (c *Client) getJob(ctx context.Context, id string) (*api.Job, error) {
+	resp, err := c.api.GetJobWithResponse(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	return resp.JSON200, nil
+}
+
+func isJobFinished(job *api.Job) bool {
+	return job.State == api.JobStateSUCCEEDED || job.State == api.JobStateFAILED
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/neatflowcv/cepher/api"
+)
+
+// labelFlag는 "-label k=v"를 여러 번 받는다.
+type labelFlag map[string]string
+
+func (f labelFlag) String() string {
+	return formatLabels(f)
+}
+
+func (f labelFlag) Set(value string) error {
+	key, label, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("%w: label must be key=value: %s", ErrUsage, value)
+	}
+
+	f[key] = label
+
+	return nil
+}
+
+// Label은 "k=v"로 label을 추가하거나 바꾸고, "k-"로 지운다. 나머지 label은 그대로 둔다.
+func (c *Client) Label(ctx context.Context, printer *Printer, args []string) error {
+	if len(args) < 2 { //nolint:mnd
+		return fmt.Errorf("%w: label takes a cluster id and key=value or key- arguments", ErrUsage)
+	}
+
+	cluster, err := c.getCluster(ctx, args[0])
+	if err != nil {
+		return err
+	}
+
+	labels := api.Labels{}
+	if cluster.Labels != nil {
+		labels = maps.Clone(*cluster.Labels)
+	}
+
+	for _, arg := range args[1:] {
+		if key, ok := strings.CutSuffix(arg, "-"); ok && !strings.Contains(arg, "=") {
+			delete(labels, key)
+
+			continue
+		}
+
+		err = labelFlag(labels).Set(arg)
+		if err != nil {
+			return err
+		}
+	}
+
+	params := &api.UpdateClusterParams{} //nolint:exhaustruct
+	if c.actor != "" {
+		params.XCepherActor = &c.actor
+	}
+
+	resp, err := c.api.UpdateClusterWithResponse(ctx, cluster.Id, params, api.UpdateCluster{
+		Labels: &labels,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update cluster: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	return printer.PrintCluster(resp.JSON200)
+}
+
+// formatLabels는 label을 key 순으로 "k=v,k=v" 형태로 나타낸다.
+func formatLabels(labels map[string]string) string {
+	var ret []string
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		ret = append(ret, key+"="+labels[key])
+	}
+
+	return strings.Join(ret, ",")
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+)
+
+var ErrUsage = errors.New("usage")
+
+const usage = `cepherctl는 cepher API를 사용하는 명령행 도구이다.
+
+사용법:
+  cepherctl [flags] <command> [arguments]
+
+명령:
+  register  cluster를 등록한다
+  list      등록된 cluster 목록을 보여준다
+  show      cluster 하나를 자세히 보여준다
+  watch     cluster 상태를 주기적으로 다시 보여준다
+  export    모든 cluster의 설정을 yaml 또는 json 문서로 내보낸다
+  import    export로 만든 문서의 cluster를 등록한다
+  job       비동기 등록 job의 단계별 결과를 보여준다
+  label     cluster의 label을 바꾼다. key=value로 추가하고 key-로 지운다
+  summary   모든 cluster의 상태를 모아서 보여준다
+  availability  기간 동안 cluster별 status 비율, 장애 수, MTTR을 보여준다
+
+flags:
+`
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, os.Args[1:])
+	if err != nil {
+		if !errors.Is(err, ErrUsage) && !errors.Is(err, flag.ErrHelp) {
+			_, _ = fmt.Fprintln(os.Stderr, "error:", err)
+		}
+
+		stop()
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("cepherctl", flag.ContinueOnError)
+	flags.Usage = func() {
+		_, _ = fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+
+	configPath := flags.String("config", defaultConfigPath(), "설정 파일 경로")
+	server := flags.String("server", "", "cepher 서버 주소 (설정 파일보다 우선)")
+	output := flags.String("o", "", "출력 형식: table, json, yaml (설정 파일보다 우선)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+
+		return ErrUsage
+	}
+
+	config, err := LoadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	if *server != "" {
+		config.Server = *server
+	}
+
+	if *output != "" {
+		config.Output = *output
+	}
+
+	printer, err := NewPrinter(os.Stdout, config.Output)
+	if err != nil {
+		return err
+	}
+
+	client, err := NewClient(config)
+	if err != nil {
+		return err
+	}
+
+	command, commandArgs := flags.Arg(0), flags.Args()[1:]
+
+	switch command {
+	case "register":
+		return client.Register(ctx, printer, commandArgs)
+	case "list":
+		return client.List(ctx, printer, commandArgs)
+	case "show":
+		return client.Show(ctx, printer, commandArgs)
+	case "watch":
+		return client.Watch(ctx, printer, commandArgs)
+	case "export":
+		return client.Export(ctx, printer, commandArgs)
+	case "import":
+		return client.Import(ctx, printer, commandArgs)
+	case "job":
+		return client.Job(ctx, printer, commandArgs)
+	case "label":
+		return client.Label(ctx, printer, commandArgs)
+	case "summary":
+		return client.Summary(ctx, printer, commandArgs)
+	case "availability":
+		return client.Availability(ctx, printer, commandArgs)
+	default:
+		flags.Usage()
+
+		return fmt.Errorf("%w: unknown command %s", ErrUsage, command)
+	}
+}
@@ -0,0 +1,485 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/neatflowcv/cepher/api"
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUnknownOutput = errors.New("unknown output format")
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+	colorGray   = "\033[90m"
+)
+
+// Printer는 API 응답을 format(table, json, yaml)에 맞게 출력한다.
+type Printer struct {
+	writer   io.Writer
+	format   string
+	terminal bool
+	color    bool
+}
+
+func NewPrinter(writer io.Writer, format string) (*Printer, error) {
+	switch format {
+	case "table", "json", "yaml":
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOutput, format)
+	}
+
+	terminal := isTerminal(writer)
+
+	return &Printer{
+		writer:   writer,
+		format:   format,
+		terminal: terminal,
+		color:    terminal && os.Getenv("NO_COLOR") == "",
+	}, nil
+}
+
+func isTerminal(writer io.Writer) bool {
+	file, ok := writer.(*os.File)
+	if !ok {
+		return false
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+func (p *Printer) PrintClusters(clusters []api.Cluster) error {
+	if p.format != "table" {
+		return p.encode(clusters)
+	}
+
+	tw := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	_, _ = fmt.Fprintln(tw, "ID\tNAME\tSTATUS\tSTABLE\tREACHABILITY\tRELEASE\tLAST CONTACT\tLABELS")
+
+	for _, cluster := range clusters {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			cluster.Id,
+			cluster.Name,
+			p.status(cluster.Status),
+			yesNo(cluster.IsStable),
+			p.reachability(cluster.Reachability.State),
+			release(cluster.Versions),
+			formatTime(cluster.Reachability.LastContactTime),
+			labels(cluster.Labels),
+		)
+	}
+
+	return tw.Flush() //nolint:wrapcheck
+}
+
+func (p *Printer) PrintCluster(cluster *api.Cluster) error {
+	if p.format != "table" {
+		return p.encode(cluster)
+	}
+
+	tw := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	row := func(key string, value string) {
+		_, _ = fmt.Fprintf(tw, "%s:\t%s\n", key, value)
+	}
+
+	row("ID", cluster.Id)
+	row("Name", cluster.Name)
+	row("Fsid", deref(cluster.Fsid))
+	row("Status", p.status(cluster.Status))
+	row("Stable", yesNo(cluster.IsStable))
+	row("Reachability", p.reachability(cluster.Reachability.State))
+	row("Last contact", formatTime(cluster.Reachability.LastContactTime))
+
+	if cluster.Reachability.LastError != nil {
+		row("Last error", *cluster.Reachability.LastError)
+	}
+
+	row("Hosts", strings.Join(cluster.Hosts, ", "))
+	row("Hosts pinned", yesNo(cluster.HostsPinned))
+	row("Entity", cluster.Entity)
+	row("Labels", labels(cluster.Labels))
+	row("Release", release(cluster.Versions))
+
+	if cluster.Versions != nil && cluster.Versions.Mixed {
+		row("Versions", "mixed")
+	}
+
+	if cluster.MonitorMap != nil {
+		var quorum []string
+
+		for _, monitor := range cluster.MonitorMap.Monitors {
+			name := monitor.Name
+			if !monitor.InQuorum {
+				name += " (out of quorum)"
+			}
+
+			quorum = append(quorum, name)
+		}
+
+		row("Monitors", strings.Join(quorum, ", "))
+	}
+
+	for _, check := range healthChecks(cluster.Detail) {
+		row("Check", check)
+	}
+
+	return tw.Flush() //nolint:wrapcheck
+}
+
+func (p *Printer) PrintImportReport(report *api.ImportReport) error {
+	if p.format != "table" {
+		return p.encode(report)
+	}
+
+	if report.DryRun {
+		_, _ = fmt.Fprintln(p.writer, "dry run: nothing was saved")
+	}
+
+	tw := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	_, _ = fmt.Fprintln(tw, "NAME\tFSID\tACTION\tCLUSTER\tMESSAGE")
+
+	for _, result := range report.Results {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			result.Name,
+			deref(result.Fsid),
+			p.importAction(result.Action),
+			deref(result.ClusterId),
+			deref(result.Message),
+		)
+	}
+
+	return tw.Flush() //nolint:wrapcheck
+}
+
+func (p *Printer) PrintJob(job *api.Job) error {
+	if p.format != "table" {
+		return p.encode(job)
+	}
+
+	tw := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	row := func(key string, value string) {
+		_, _ = fmt.Fprintf(tw, "%s:\t%s\n", key, value)
+	}
+
+	row("Job", job.Id)
+	row("Kind", string(job.Kind))
+	row("Cluster", job.ClusterId)
+	row("State", p.jobState(job.State))
+	row("Created", formatTime(&job.CreatedAt))
+	row("Updated", formatTime(&job.UpdatedAt))
+
+	err := tw.Flush()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if len(job.Steps) == 0 {
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(p.writer)
+
+	tw = tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	_, _ = fmt.Fprintln(tw, "STEP\tSTATE\tDURATION\tMESSAGE")
+
+	for _, step := range job.Steps {
+		duration := "-"
+		if step.FinishedAt != nil {
+			duration = step.FinishedAt.Sub(step.StartedAt).Round(time.Millisecond).String()
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n",
+			step.Name,
+			p.jobState(step.State),
+			duration,
+			deref(step.Message),
+		)
+	}
+
+	return tw.Flush() //nolint:wrapcheck
+}
+
+func (p *Printer) PrintSummary(summary *api.FleetSummary, groupBy string) error {
+	if p.format != "table" {
+		return p.encode(summary)
+	}
+
+	tw := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	_, _ = fmt.Fprintln(tw, "TOTAL\tHEALTH_OK\tHEALTH_WARN\tHEALTH_ERR\tHEALTH_UNKNOWN\tPENDING\tSTABLE")
+	_, _ = fmt.Fprintf(tw, "%d\t%s\t%d\n", summary.Total, statusCounts(summary.Statuses), summary.Stable)
+
+	err := tw.Flush()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if len(summary.TopChecks) > 0 {
+		_, _ = fmt.Fprintln(p.writer)
+
+		tw = tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+		_, _ = fmt.Fprintln(tw, "CHECK\tSEVERITY\tCLUSTERS")
+
+		for _, check := range summary.TopChecks {
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%d\n",
+				check.Code,
+				p.status(api.ClusterStatus(check.Severity)),
+				check.Count,
+			)
+		}
+
+		err = tw.Flush()
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+	}
+
+	if summary.Groups == nil {
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(p.writer)
+
+	tw = tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	_, _ = fmt.Fprintf(tw, "%s\tTOTAL\tHEALTH_OK\tHEALTH_WARN\tHEALTH_ERR\tHEALTH_UNKNOWN\tPENDING\tSTABLE\n",
+		strings.ToUpper(groupBy))
+
+	for _, group := range *summary.Groups {
+		value := "(none)"
+		if group.Value != nil {
+			value = *group.Value
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%s\t%d\n", value, group.Total, statusCounts(group.Statuses), group.Stable)
+	}
+
+	return tw.Flush() //nolint:wrapcheck
+}
+
+func (p *Printer) PrintAvailability(availabilities []api.ClusterAvailability) error {
+	if p.format != "table" {
+		return p.encode(availabilities)
+	}
+
+	tw := tabwriter.NewWriter(p.writer, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	_, _ = fmt.Fprintln(tw, "ID\tNAME\tRECORDED\tOK %\tWARN %\tERR %\tUNKNOWN %\tINCIDENTS\tMTTR")
+
+	for _, availability := range availabilities {
+		percents := map[api.ClusterStatus]float64{}
+		for _, state := range availability.States {
+			percents[state.Status] = state.Percent
+		}
+
+		mttr := "-"
+		if availability.MttrSeconds != nil {
+			mttr = seconds(*availability.MttrSeconds)
+		}
+
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%.3f\t%.3f\t%.3f\t%.3f\t%d\t%s\n",
+			availability.ClusterId,
+			availability.Name,
+			seconds(availability.RecordedSeconds),
+			percents[api.ClusterStatusHEALTHOK],
+			percents[api.ClusterStatusHEALTHWARN],
+			percents[api.ClusterStatusHEALTHERR],
+			percents[api.ClusterStatusHEALTHUNKNOWN],
+			availability.Incidents,
+			mttr,
+		)
+	}
+
+	return tw.Flush() //nolint:wrapcheck
+}
+
+func (p *Printer) encode(value any) error {
+	switch p.format {
+	case "json":
+		encoder := json.NewEncoder(p.writer)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(value) //nolint:wrapcheck
+	default:
+		// api 모델에는 json 태그만 있으므로 json을 거쳐서 같은 필드 이름으로 출력한다.
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to marshal: %w", err)
+		}
+
+		var generic any
+
+		err = json.Unmarshal(data, &generic)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal: %w", err)
+		}
+
+		encoder := yaml.NewEncoder(p.writer)
+		defer encoder.Close()
+
+		return encoder.Encode(generic) //nolint:wrapcheck
+	}
+}
+
+func (p *Printer) status(status api.ClusterStatus) string {
+	switch status {
+	case api.ClusterStatusHEALTHOK:
+		return p.paint(colorGreen, string(status))
+	case api.ClusterStatusHEALTHWARN:
+		return p.paint(colorYellow, string(status))
+	case api.ClusterStatusHEALTHERR:
+		return p.paint(colorRed, string(status))
+	default:
+		return p.paint(colorGray, string(status))
+	}
+}
+
+func (p *Printer) reachability(state api.ReachabilityState) string {
+	switch state {
+	case api.REACHABLE:
+		return p.paint(colorGreen, string(state))
+	case api.UNKNOWN:
+		return p.paint(colorGray, string(state))
+	default:
+		return p.paint(colorRed, string(state))
+	}
+}
+
+func (p *Printer) importAction(action api.ImportResultAction) string {
+	switch action {
+	case api.CREATED, api.UPDATED:
+		return p.paint(colorGreen, string(action))
+	case api.FAILED:
+		return p.paint(colorRed, string(action))
+	default:
+		return p.paint(colorGray, string(action))
+	}
+}
+
+func (p *Printer) jobState(state api.JobState) string {
+	switch state {
+	case api.JobStateSUCCEEDED:
+		return p.paint(colorGreen, string(state))
+	case api.JobStateFAILED:
+		return p.paint(colorRed, string(state))
+	default:
+		return p.paint(colorYellow, string(state))
+	}
+}
+
+// paint는 터미널에 출력할 때만 색을 입힌다.
+// 한 열의 모든 칸에 같은 길이의 escape sequence가 붙으므로 tabwriter의 열 정렬은 유지된다.
+func (p *Printer) paint(color string, text string) string {
+	if !p.color {
+		return text
+	}
+
+	return color + text + colorReset
+}
+
+// healthChecks는 cluster detail에 담긴 health check를 "CODE (severity): summary" 형태로 바꾼다.
+func healthChecks(detail any) []string {
+	checks, ok := detail.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	var ret []string
+
+	for code, value := range checks {
+		check, _ := value.(map[string]any)
+		severity, _ := check["severity"].(string)
+		summary, _ := check["summary"].(map[string]any)
+		message, _ := summary["message"].(string)
+
+		ret = append(ret, fmt.Sprintf("%s (%s): %s", code, severity, message))
+	}
+
+	slices.Sort(ret)
+
+	return ret
+}
+
+// statusCounts는 status마다의 cluster 수를 summary 표의 열 순서대로 나타낸다.
+func statusCounts(counts api.StatusCounts) string {
+	var ret []string
+	for _, status := range []api.ClusterStatus{
+		api.ClusterStatusHEALTHOK,
+		api.ClusterStatusHEALTHWARN,
+		api.ClusterStatusHEALTHERR,
+		api.ClusterStatusHEALTHUNKNOWN,
+		api.ClusterStatusPENDING,
+	} {
+		ret = append(ret, strconv.Itoa(counts[string(status)]))
+	}
+
+	return strings.Join(ret, "\t")
+}
+
+func release(versions *api.Versions) string {
+	if versions == nil || versions.Release == "" {
+		return "-"
+	}
+
+	return versions.Release
+}
+
+func labels(labels *api.Labels) string {
+	if labels == nil || len(*labels) == 0 {
+		return "-"
+	}
+
+	return formatLabels(*labels)
+}
+
+// seconds는 초 단위 시간을 "1h2m3s"처럼 나타낸다.
+func seconds(value float64) string {
+	return (time.Duration(value) * time.Second).Round(time.Second).String()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+
+	return t.Local().Format(time.DateTime)
+}
+
+func deref(value *string) string {
+	if value == nil || *value == "" {
+		return "-"
+	}
+
+	return *value
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return "no"
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/neatflowcv/cepher/api"
+)
+
+// Summary는 모든 cluster의 현재 상태를 모아서 출력한다.
+func (c *Client) Summary(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("summary", flag.ContinueOnError)
+	groupBy := flags.String("group-by", "", "이 label의 value마다 cluster를 나눈다")
+	top := flags.Int("top", 0, "보여줄 health check의 최대 개수 (0이면 서버 기본값)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if flags.NArg() != 0 {
+		return fmt.Errorf("%w: summary takes no arguments", ErrUsage)
+	}
+
+	params := &api.GetSummaryParams{} //nolint:exhaustruct
+	if *groupBy != "" {
+		params.GroupBy = groupBy
+	}
+
+	if *top != 0 {
+		params.Top = top
+	}
+
+	resp, err := c.api.GetSummaryWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to get summary: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	return printer.PrintSummary(resp.JSON200, *groupBy)
+}
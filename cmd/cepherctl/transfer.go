@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/neatflowcv/cepher/api"
+	"gopkg.in/yaml.v3"
+)
+
+// Export는 등록된 모든 cluster의 설정을 문서로 내려받는다.
+// table 형식으로는 문서를 옮길 수 없으므로 -o가 table이면 yaml로 출력한다.
+func (c *Client) Export(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	keyMode := flags.String("keys", "omit", "key를 담는 방법: omit, encrypt, plain")
+	passphraseFile := flags.String("passphrase-file", "", "key를 암호화할 passphrase가 담긴 파일 (없으면 CEPHER_PASSPHRASE)")
+	file := flags.String("f", "", "문서를 저장할 파일 (없으면 표준 출력)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	params := &api.ExportClustersParams{} //nolint:exhaustruct
+
+	mode := api.KeyMode(*keyMode)
+	params.KeyMode = &mode
+
+	if mode == api.Encrypt {
+		passphrase, err := readPassphrase(*passphraseFile)
+		if err != nil {
+			return err
+		}
+
+		params.XCepherPassphrase = &passphrase
+	}
+
+	resp, err := c.api.ExportClustersWithResponse(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to export clusters: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	format := printer.format
+	if format == "table" {
+		format = "yaml"
+	}
+
+	writer := printer.writer
+
+	if *file != "" {
+		const permission = 0600
+
+		out, err := os.OpenFile(*file, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, permission)
+		if err != nil {
+			return fmt.Errorf("failed to open %s: %w", *file, err)
+		}
+		defer out.Close()
+
+		writer = out
+	}
+
+	documentPrinter, err := NewPrinter(writer, format)
+	if err != nil {
+		return err
+	}
+
+	return documentPrinter.encode(resp.JSON200)
+}
+
+// Import는 export로 만든 yaml 또는 json 문서의 cluster를 등록한다.
+func (c *Client) Import(ctx context.Context, printer *Printer, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	dryRun := flags.Bool("dry-run", false, "저장하지 않고 결과만 보여준다")
+	onConflict := flags.String("on-conflict", "skip", "같은 cluster가 이미 있을 때: skip, overwrite, fail")
+	passphraseFile := flags.String("passphrase-file", "", "key를 복호화할 passphrase가 담긴 파일 (없으면 CEPHER_PASSPHRASE)")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: import takes a document file (- for standard input)", ErrUsage)
+	}
+
+	document, err := readDocument(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	conflict := api.ImportClustersParamsOnConflict(*onConflict)
+	params := &api.ImportClustersParams{ //nolint:exhaustruct
+		DryRun:     dryRun,
+		OnConflict: &conflict,
+	}
+
+	if c.actor != "" {
+		params.XCepherActor = &c.actor
+	}
+
+	if document.KeyMode == api.Encrypt {
+		passphrase, err := readPassphrase(*passphraseFile)
+		if err != nil {
+			return err
+		}
+
+		params.XCepherPassphrase = &passphrase
+	}
+
+	resp, err := c.api.ImportClustersWithResponse(ctx, params, *document)
+	if err != nil {
+		return fmt.Errorf("failed to import clusters: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		return unexpected(resp.HTTPResponse, resp.Body)
+	}
+
+	return printer.PrintImportReport(resp.JSON200)
+}
+
+// readDocument는 문서를 읽는다. json은 yaml의 부분집합이므로 yaml로 읽는다.
+func readDocument(path string) (*api.ExportDocument, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read document: %w", err)
+	}
+
+	var generic any
+
+	err = yaml.Unmarshal(data, &generic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse document: %w", err)
+	}
+
+	// api 모델에는 json 태그만 있으므로 json을 거쳐서 같은 필드 이름으로 읽는다.
+	converted, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert document: %w", err)
+	}
+
+	var document api.ExportDocument
+
+	decoder := json.NewDecoder(bytes.NewReader(converted))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode document: %w", err)
+	}
+
+	return &document, nil
+}
+
+func readPassphrase(path string) (string, error) {
+	if path == "" {
+		passphrase := os.Getenv("CEPHER_PASSPHRASE")
+		if passphrase == "" {
+			return "", fmt.Errorf("%w: -passphrase-file or CEPHER_PASSPHRASE is required for encrypted keys", ErrUsage)
+		}
+
+		return passphrase, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase file: %w", err)
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
@@ -0,0 +1,143 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/repository"
+)
+
+// ActorScheduler는 scheduler가 스스로 바꾼 내용을 audit log에 남길 때의 actor이다.
+const ActorScheduler = "scheduler"
+
+type AuditEntry struct {
+	ID        string
+	Time      time.Time
+	Actor     string
+	ClusterID string
+	Action    string
+	Detail    string
+	Changes   []*FieldChange
+}
+
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+func NewAuditEntries(entries []*domain.AuditEntry) []*AuditEntry {
+	var ret []*AuditEntry
+
+	for _, entry := range entries {
+		var changes []*FieldChange
+		for _, change := range entry.Changes() {
+			changes = append(changes, &FieldChange{
+				Field:  change.Field(),
+				Before: change.Before(),
+				After:  change.After(),
+			})
+		}
+
+		ret = append(ret, &AuditEntry{
+			ID:        entry.ID(),
+			Time:      entry.Time(),
+			Actor:     entry.Actor(),
+			ClusterID: entry.ClusterID(),
+			Action:    string(entry.Action()),
+			Detail:    entry.Detail(),
+			Changes:   changes,
+		})
+	}
+
+	return ret
+}
+
+type AuditQuery struct {
+	ClusterID string
+	Actor     string
+	Action    string
+	Since     time.Time
+	Until     time.Time
+	// Limit은 최근 entry부터 반환할 최대 개수이다. 0이면 기본값을 쓴다.
+	Limit int
+}
+
+// ListAuditEntries는 조건에 맞는 audit log를 최근 것부터 반환한다.
+func (s *Service) ListAuditEntries(ctx context.Context, query *AuditQuery) ([]*AuditEntry, error) {
+	const defaultLimit = 100
+
+	var action domain.AuditAction
+
+	if query.Action != "" {
+		var err error
+
+		action, err = domain.NewAuditAction(query.Action)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create audit action: %w", err)
+		}
+	}
+
+	if query.Limit < 0 {
+		return nil, domain.InvalidParameterError("limit")
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	entries, err := s.repository.ListAuditEntries(ctx, &repository.AuditFilter{
+		ClusterID: query.ClusterID,
+		Actor:     query.Actor,
+		Action:    action,
+		Since:     query.Since,
+		Until:     query.Until,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+
+	slices.Reverse(entries)
+
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return NewAuditEntries(entries), nil
+}
+
+// recordAudit은 before에서 after로 바뀐 설정을 audit log에 남긴다.
+// 이미 반영된 변경을 되돌릴 수 없으므로 기록에 실패해도 본래 작업을 중단하지 않는다.
+func (s *Service) recordAudit(
+	ctx context.Context,
+	actor string,
+	clusterID string,
+	action domain.AuditAction,
+	detail string,
+	before *domain.Cluster,
+	after *domain.Cluster,
+	now time.Time,
+) {
+	var changes []*domain.FieldChange
+	if after != nil {
+		changes = domain.DiffClusters(before, after)
+	}
+
+	s.logger.InfoContext(ctx, "audit", "actor", actor, "cluster_id", clusterID, "action", action, "detail", detail)
+
+	entry, err := domain.NewAuditEntry(s.idGenerator.GenerateID(), now, actor, clusterID, action, detail, changes)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create audit entry", "cluster_id", clusterID, "error", err)
+
+		return
+	}
+
+	err = s.repository.AppendAuditEntry(ctx, entry)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to append audit entry", "cluster_id", clusterID, "error", err)
+	}
+}
@@ -0,0 +1,119 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+// ClusterAvailability는 기간 동안 cluster가 각 status에 머문 시간과 장애 통계이다.
+type ClusterAvailability struct {
+	ClusterID string
+	Name      string
+	Since     time.Time
+	Until     time.Time
+	// Durations와 Ratios는 status마다의 시간과, status를 알고 있는 시간 중의 비율이다.
+	Durations map[string]time.Duration
+	Ratios    map[string]float64
+	// Recorded는 기간 중 status를 알고 있는 시간이다. 등록 전이나 기록을 시작하기 전은 빠진다.
+	Recorded          time.Duration
+	Incidents         int
+	ResolvedIncidents int
+	MTTR              time.Duration
+}
+
+type AvailabilityQuery struct {
+	// ClusterID가 비어 있으면 모든 cluster를 계산한다.
+	ClusterID string
+	// Since가 zero이면 Until의 30일 전부터이다.
+	Since time.Time
+	// Until이 zero이거나 Now보다 뒤이면 Now까지이다. 아직 오지 않은 시간은 status를 알 수 없다.
+	Until time.Time
+	Now   time.Time
+}
+
+// ListAvailability는 status 변경 기록으로 cluster마다의 가용성을 계산한다.
+func (s *Service) ListAvailability(ctx context.Context, query *AvailabilityQuery) ([]*ClusterAvailability, error) {
+	const defaultWindow = 30 * 24 * time.Hour
+
+	until := query.Until
+	if until.IsZero() || until.After(query.Now) {
+		until = query.Now
+	}
+
+	since := query.Since
+	if since.IsZero() {
+		since = until.Add(-defaultWindow)
+	}
+
+	if !until.After(since) {
+		return nil, domain.InvalidParameterError("until")
+	}
+
+	var clusters []*domain.Cluster
+
+	if query.ClusterID != "" {
+		cluster, err := s.repository.GetCluster(ctx, query.ClusterID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cluster: %w", err)
+		}
+
+		clusters = append(clusters, cluster)
+	} else {
+		var err error
+
+		clusters, err = s.repository.ListClusters(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list clusters: %w", err)
+		}
+	}
+
+	var ret []*ClusterAvailability
+
+	for _, cluster := range clusters {
+		changes, err := s.repository.ListStatusChanges(ctx, cluster.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list status changes: %w", err)
+		}
+
+		availability, err := domain.ComputeAvailability(changes, since, until)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compute availability: %w", err)
+		}
+
+		ret = append(ret, newClusterAvailability(cluster, availability))
+	}
+
+	return ret, nil
+}
+
+func newClusterAvailability(cluster *domain.Cluster, availability *domain.Availability) *ClusterAvailability {
+	durations := map[string]time.Duration{}
+	ratios := map[string]float64{}
+
+	for _, status := range []domain.ClusterStatus{
+		domain.ClusterStatusHealthOK,
+		domain.ClusterStatusHealthWarning,
+		domain.ClusterStatusHealthError,
+		domain.ClusterStatusUnknown,
+		domain.ClusterStatusPending,
+	} {
+		durations[string(status)] = availability.Duration(status)
+		ratios[string(status)] = availability.Ratio(status)
+	}
+
+	return &ClusterAvailability{
+		ClusterID:         cluster.ID(),
+		Name:              cluster.Name(),
+		Since:             availability.Since(),
+		Until:             availability.Until(),
+		Durations:         durations,
+		Ratios:            ratios,
+		Recorded:          availability.Recorded(),
+		Incidents:         availability.Incidents(),
+		ResolvedIncidents: availability.ResolvedIncidents(),
+		MTTR:              availability.MTTR(),
+	}
+}
@@ -0,0 +1,127 @@
+package flow_test
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/app/flow"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/idgenerator/ulid"
+	"github.com/neatflowcv/cepher/internal/pkg/repository/file"
+)
+
+func TestService_ListAvailability_Until(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	since := now.Add(-2 * time.Hour)
+
+	tests := []struct {
+		name      string
+		until     time.Time
+		wantUntil time.Time
+	}{
+		{name: "default", until: time.Time{}, wantUntil: now},
+		{name: "past", until: now.Add(-time.Hour), wantUntil: now.Add(-time.Hour)},
+		{name: "future", until: now.Add(24 * time.Hour), wantUntil: now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+			repo := file.NewRepository(t.TempDir(), logger)
+			service := flow.NewService(ulid.NewGenerator(), nil, repo, &fakeProber{reachable: true}, logger)
+
+			createCluster(t, repo, domain.ClusterStatusHealthOK, since)
+
+			change, err := domain.NewStatusChange("cluster-1", domain.ClusterStatusHealthOK, domain.ClusterStatusHealthOK, since)
+			if err != nil {
+				t.Fatalf("failed to create status change: %v", err)
+			}
+
+			err = repo.AppendStatusChange(t.Context(), change)
+			if err != nil {
+				t.Fatalf("AppendStatusChange() error = %v", err)
+			}
+
+			availabilities, err := service.ListAvailability(t.Context(), &flow.AvailabilityQuery{
+				ClusterID: "cluster-1",
+				Since:     since,
+				Until:     tt.until,
+				Now:       now,
+			})
+			if err != nil {
+				t.Fatalf("ListAvailability() error = %v", err)
+			}
+
+			got := availabilities[0]
+			if !got.Until.Equal(tt.wantUntil) {
+				t.Errorf("until = %v, want %v", got.Until, tt.wantUntil)
+			}
+
+			if want := tt.wantUntil.Sub(since); got.Recorded != want {
+				t.Errorf("recorded = %v, want %v", got.Recorded, want)
+			}
+		})
+	}
+}
+
+func TestService_ListAvailability_RatiosCoverRecorded(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	since := now.Add(-2 * time.Hour)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	repo := file.NewRepository(t.TempDir(), logger)
+	service := flow.NewService(ulid.NewGenerator(), nil, repo, &fakeProber{reachable: true}, logger)
+
+	createCluster(t, repo, domain.ClusterStatusHealthOK, since)
+
+	for _, change := range []struct {
+		from, to domain.ClusterStatus
+		time     time.Time
+	}{
+		{from: domain.ClusterStatusPending, to: domain.ClusterStatusPending, time: since},
+		{from: domain.ClusterStatusPending, to: domain.ClusterStatusHealthOK, time: since.Add(time.Hour)},
+	} {
+		statusChange, err := domain.NewStatusChange("cluster-1", change.from, change.to, change.time)
+		if err != nil {
+			t.Fatalf("failed to create status change: %v", err)
+		}
+
+		err = repo.AppendStatusChange(t.Context(), statusChange)
+		if err != nil {
+			t.Fatalf("AppendStatusChange() error = %v", err)
+		}
+	}
+
+	availabilities, err := service.ListAvailability(t.Context(), &flow.AvailabilityQuery{
+		ClusterID: "cluster-1",
+		Since:     since,
+		Until:     time.Time{},
+		Now:       now,
+	})
+	if err != nil {
+		t.Fatalf("ListAvailability() error = %v", err)
+	}
+
+	got := availabilities[0]
+
+	var sum float64
+	for _, ratio := range got.Ratios {
+		sum += ratio
+	}
+
+	if sum != 1 {
+		t.Errorf("sum of ratios = %v, want 1", sum)
+	}
+
+	if ratio := got.Ratios[string(domain.ClusterStatusPending)]; ratio != 0.5 {
+		t.Errorf("pending ratio = %v, want 0.5", ratio)
+	}
+}
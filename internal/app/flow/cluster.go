@@ -7,34 +7,80 @@ import (
 )
 
 type Cluster struct {
-	ID       string
-	Name     string
-	Status   string
-	IsStable bool
-	Detail   any
+	ID              string
+	Name            string
+	Fsid            string
+	Hosts           []string
+	FallbackHosts   []string
+	HostsPinned     bool
+	Entity          string
+	Labels          map[string]string
+	Status          string
+	IsStable        bool
+	Detail          any
+	Reachability    string
+	LastError       string
+	LastContactTime time.Time
+	MonitorMap      *MonitorMap
+	Probes          []*MonitorProbe
+	Versions        *Versions
 }
 
 type RegisterCluster struct {
-	Name  string
+	Name string
+	// Fsid는 알고 있으면 지정한다. 지정하면 다른 cluster에 접근한 경우 등록하지 않는다.
+	Fsid  string
 	Hosts []string
-	Key   string
+	// Entity는 접속할 때 쓰는 ceph 사용자이다. 비어 있으면 client.admin이다.
+	Entity string
+	Key    string
+	Labels map[string]string
+	// Actor는 등록한 사람이다. audit log에 남긴다.
+	Actor string
 	Now   time.Time
 }
 
-func NewCluster(cluster *domain.Cluster) *Cluster {
+// NewCluster는 now 기준으로 안정 여부를 판단한다.
+func NewCluster(cluster *domain.Cluster, now time.Time) *Cluster {
 	return &Cluster{
-		ID:       cluster.ID(),
-		Name:     cluster.Name(),
-		Status:   string(cluster.Status()),
-		IsStable: domain.IsClusterStable(cluster, cluster.LastBadTime()),
-		Detail:   cluster.Detail(),
+		ID:              cluster.ID(),
+		Name:            cluster.Name(),
+		Fsid:            cluster.Fsid(),
+		Hosts:           newHosts(cluster.Hosts()),
+		FallbackHosts:   newHosts(cluster.FallbackHosts()),
+		HostsPinned:     cluster.HostsPinned(),
+		Entity:          cluster.Entity(),
+		Labels:          cluster.Labels(),
+		Status:          string(cluster.Status()),
+		IsStable:        domain.IsClusterStable(cluster, now),
+		Detail:          cluster.Detail(),
+		Reachability:    string(cluster.Connection().Reachability()),
+		LastError:       cluster.Connection().LastError(),
+		LastContactTime: cluster.Connection().LastContactTime(),
+		MonitorMap:      NewMonitorMap(cluster.MonitorMap()),
+		Probes:          NewMonitorProbes(cluster.Probes()),
+		Versions:        NewVersions(cluster.Versions()),
 	}
 }
 
-func NewClusters(clusters []*domain.Cluster) []*Cluster {
+// IsPending은 비동기 등록을 마치지 않은 cluster인지 여부이다. pending cluster는 주기적으로 확인하지 않는다.
+func (c *Cluster) IsPending() bool {
+	return c.Status == string(domain.ClusterStatusPending)
+}
+
+func newHosts(hosts []*domain.Address) []string {
+	var ret []string
+	for _, host := range hosts {
+		ret = append(ret, host.String())
+	}
+
+	return ret
+}
+
+func NewClusters(clusters []*domain.Cluster, now time.Time) []*Cluster {
 	var ret []*Cluster
 	for _, cluster := range clusters {
-		ret = append(ret, NewCluster(cluster))
+		ret = append(ret, NewCluster(cluster, now))
 	}
 
 	return ret
@@ -0,0 +1,88 @@
+package flow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type RunCommand struct {
+	Args []string
+	// Actor는 커맨드를 실행한 사람이다. audit log에 남긴다.
+	Actor string
+	Now   time.Time
+}
+
+type CommandResult struct {
+	Command  string
+	Output   json.RawMessage
+	Duration time.Duration
+}
+
+type CommandSpec struct {
+	Prefix  string
+	MaxArgs int
+	Timeout time.Duration
+}
+
+func (s *Service) ListCommands() []*CommandSpec {
+	var ret []*CommandSpec
+	for _, spec := range domain.AllowedCommands() {
+		ret = append(ret, &CommandSpec{
+			Prefix:  spec.Prefix(),
+			MaxArgs: spec.MaxArgs(),
+			Timeout: spec.Timeout(),
+		})
+	}
+
+	return ret
+}
+
+// RunCommand는 허용된 읽기 전용 ceph 커맨드를 실행한다.
+// 커맨드마다 정해진 시간 안에 끝나지 않으면 중단하고, 누가 무엇을 실행했는지 audit log에 남긴다.
+func (s *Service) RunCommand(ctx context.Context, id string, runCommand *RunCommand) (*CommandResult, error) {
+	command, err := domain.NewCommand(runCommand.Args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create command: %w", err)
+	}
+
+	cluster, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	client, err := s.factory.NewClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	defer client.Close()
+
+	commandCtx, cancel := context.WithTimeout(ctx, command.Timeout())
+	defer cancel()
+
+	start := time.Now()
+	output, err := client.RunCommand(commandCtx, command)
+	duration := time.Since(start)
+
+	result := "ok"
+	if err != nil {
+		result = err.Error()
+	}
+
+	// 커맨드가 시간을 넘기거나 요청이 끊겨도 실행한 기록은 남긴다.
+	s.recordAudit(context.WithoutCancel(ctx), runCommand.Actor, id, domain.AuditActionCommandRun,
+		fmt.Sprintf("%s (%v): %s", command, duration, result), nil, nil, runCommand.Now)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCommandFailed, err)
+	}
+
+	return &CommandResult{
+		Command:  command.String(),
+		Output:   output,
+		Duration: duration,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/pkg/cephsetup"
+)
+
+type RegisterClusterFromConfig struct {
+	Name string
+	// CephConf는 ceph.conf의 내용이다. mon_host와 fsid를 읽는다.
+	CephConf string
+	// Keyring은 keyring 파일의 내용이다.
+	Keyring string
+	// Entity는 keyring에서 쓸 사용자이다. 비어 있으면 client.admin을 쓰고, 없으면 keyring의 유일한 client를 쓴다.
+	Entity string
+	Labels map[string]string
+	Actor  string
+	Now    time.Time
+}
+
+// RegisterClusterFromConfig는 운영자가 가진 ceph.conf와 keyring으로 cluster를 등록한다.
+func (s *Service) RegisterClusterFromConfig(
+	ctx context.Context,
+	registerCluster *RegisterClusterFromConfig,
+) (*Cluster, error) {
+	converted, err := registerCluster.toRegisterCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.RegisterCluster(ctx, converted)
+}
+
+// StartRegisterClusterFromConfig는 ceph.conf와 keyring으로 비동기 등록을 시작한다.
+func (s *Service) StartRegisterClusterFromConfig(
+	ctx context.Context,
+	registerCluster *RegisterClusterFromConfig,
+) (*Job, error) {
+	converted, err := registerCluster.toRegisterCluster()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.StartRegisterCluster(ctx, converted)
+}
+
+// toRegisterCluster는 ceph.conf와 keyring에서 mon_host, fsid, entity, key를 읽는다.
+func (r *RegisterClusterFromConfig) toRegisterCluster() (*RegisterCluster, error) {
+	config, err := cephsetup.ParseConfig(strings.NewReader(r.CephConf))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.InvalidParameterError("ceph_conf"), err)
+	}
+
+	entries, err := cephsetup.ParseKeyring(strings.NewReader(r.Keyring))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.InvalidParameterError("keyring"), err)
+	}
+
+	entry, err := cephsetup.FindKeyringEntry(entries, r.Entity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.InvalidParameterError("entity"), err)
+	}
+
+	return &RegisterCluster{
+		Name:   r.Name,
+		Fsid:   config.Fsid,
+		Hosts:  slices.Concat(config.MonHosts...),
+		Entity: entry.Entity,
+		Key:    entry.Key,
+		Labels: r.Labels,
+		Actor:  r.Actor,
+		Now:    r.Now,
+	}, nil
+}
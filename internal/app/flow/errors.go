@@ -0,0 +1,12 @@
+package flow
+
+import "errors"
+
+var (
+	ErrHostsRejected      = errors.New("hosts rejected")
+	ErrCommandFailed      = errors.New("command failed")
+	ErrUnsupportedVersion = errors.New("unsupported document version")
+	ErrImportConflict     = errors.New("import conflict")
+	// ErrClusterUnreachable은 등록하려는 cluster에 접근하지 못한 것이다. 원인은 함께 감싼 client 오류로 구분한다.
+	ErrClusterUnreachable = errors.New("cluster unreachable")
+)
@@ -0,0 +1,28 @@
+package flow
+
+import (
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type Event struct {
+	ID      string
+	Kind    string
+	Message string
+	Time    time.Time
+}
+
+func NewEvents(events []*domain.Event) []*Event {
+	var ret []*Event
+	for _, event := range events {
+		ret = append(ret, &Event{
+			ID:      event.ID(),
+			Kind:    string(event.Kind()),
+			Message: event.Message(),
+			Time:    event.Time(),
+		})
+	}
+
+	return ret
+}
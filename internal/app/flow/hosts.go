@@ -0,0 +1,156 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type UpdateHosts struct {
+	// Hosts가 비어 있으면 hosts는 그대로 두고 Pinned만 반영한다.
+	Hosts  []string
+	Pinned bool
+	// Actor는 바꾼 사람이다. audit log에 남긴다.
+	Actor string
+	Now   time.Time
+}
+
+// UpdateHosts는 운영자가 지정한 hosts로 바꾸고, 자동 갱신 여부를 정한다.
+func (s *Service) UpdateHosts(ctx context.Context, id string, updateHosts *UpdateHosts) (*Cluster, error) {
+	cluster, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	changed := cluster
+
+	if len(updateHosts.Hosts) > 0 {
+		hosts, err := domain.NewAddressesFromHosts(updateHosts.Hosts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain addresses: %w", err)
+		}
+
+		changed, err = s.replaceHosts(ctx, changed, hosts, updateHosts.Now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	changed = changed.PinHosts(updateHosts.Pinned)
+
+	if changed != cluster {
+		err = s.repository.UpdateCluster(ctx, changed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update cluster: %w", err)
+		}
+
+		s.recordAudit(ctx, updateHosts.Actor, id, domain.AuditActionHostsUpdated,
+			"hosts updated", cluster, changed, updateHosts.Now)
+	}
+
+	return NewCluster(changed, updateHosts.Now), nil
+}
+
+// replaceHosts는 새 hosts로 접근할 수 있는지 확인한 뒤에 hosts를 바꾼다.
+// 이전 hosts는 fallback으로 남는다.
+func (s *Service) replaceHosts(
+	ctx context.Context,
+	cluster *domain.Cluster,
+	hosts []*domain.Address,
+	now time.Time,
+) (*domain.Cluster, error) {
+	candidate, err := cluster.SetHosts(hosts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set hosts: %w", err)
+	}
+
+	if candidate == cluster {
+		return cluster, nil
+	}
+
+	err = s.validateHosts(ctx, candidate)
+	if err != nil {
+		s.recordEvent(ctx, cluster.ID(), domain.EventKindHostsRejected,
+			fmt.Sprintf("hosts %s rejected: %v", formatHosts(hosts), err), now)
+
+		return nil, err
+	}
+
+	s.recordEvent(ctx, cluster.ID(), domain.EventKindHostsChanged,
+		fmt.Sprintf("hosts changed from %s to %s", formatHosts(cluster.Hosts()), formatHosts(hosts)), now)
+
+	return candidate, nil
+}
+
+// rollbackHosts는 fallback hosts로 접근할 수 있으면 fallback hosts로 되돌린다.
+// 되돌리지 못하면 cluster를 그대로 반환한다.
+func (s *Service) rollbackHosts(ctx context.Context, cluster *domain.Cluster, now time.Time) *domain.Cluster {
+	candidate, err := cluster.RollbackHosts()
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to rollback hosts", "cluster_id", cluster.ID(), "error", err)
+
+		return cluster
+	}
+
+	err = s.validateHosts(ctx, candidate)
+	if err != nil {
+		s.logger.WarnContext(ctx, "fallback hosts are not usable either", "cluster_id", cluster.ID(), "error", err)
+
+		return cluster
+	}
+
+	message := fmt.Sprintf("hosts rolled back from %s to %s", formatHosts(cluster.Hosts()), formatHosts(candidate.Hosts()))
+	s.recordEvent(ctx, cluster.ID(), domain.EventKindHostsRolledBack, message, now)
+	s.recordAudit(ctx, ActorScheduler, cluster.ID(), domain.AuditActionHostsRolledBack, message, cluster, candidate, now)
+
+	return candidate
+}
+
+// validateHosts는 cluster의 hosts로 같은 cluster에 접근할 수 있는지 확인한다.
+func (s *Service) validateHosts(ctx context.Context, cluster *domain.Cluster) error {
+	// 시험하는 hosts로 session을 바꾸면 같은 cluster가 쓰고 있는 session이 버려지므로 따로 만든다.
+	client, err := s.factory.NewCandidateClient(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create client: %w", ErrHostsRejected, err)
+	}
+	defer client.Close()
+
+	monitorMap, err := client.GetMonitorMap(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: failed to get monitor map: %w", ErrHostsRejected, err)
+	}
+
+	if cluster.Fsid() != "" && cluster.Fsid() != monitorMap.Fsid() {
+		return fmt.Errorf("%w: %w: expected fsid %s but got %s",
+			ErrHostsRejected, domain.ErrIdentityMismatch, cluster.Fsid(), monitorMap.Fsid())
+	}
+
+	return nil
+}
+
+// canRecoverByHosts는 hosts를 바꿔서 접근할 수 있게 될 여지가 있는지 판단한다.
+// 인증 실패나 container runtime 오류는 hosts와 무관하다.
+func canRecoverByHosts(cluster *domain.Cluster) bool {
+	if cluster.HostsPinned() || len(cluster.FallbackHosts()) == 0 {
+		return false
+	}
+
+	switch cluster.Connection().Reachability() {
+	case domain.ReachabilityTimeout, domain.ReachabilityUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
+func formatHosts(hosts []*domain.Address) string {
+	var ret []string
+	for _, host := range hosts {
+		ret = append(ret, host.String())
+	}
+
+	return "[" + strings.Join(ret, ",") + "]"
+}
@@ -0,0 +1,410 @@
+package flow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/repository"
+)
+
+// 비동기 등록 job의 단계이다. 이 순서대로 진행하고, 실패한 단계에서 멈춘다.
+const (
+	// JobStepConnectivity는 monitor마다 TCP로 접속해 본다.
+	JobStepConnectivity = "CONNECTIVITY"
+	// JobStepAuthentication은 key로 monmap을 가져와 인증과 fsid를 확인한다.
+	JobStepAuthentication = "AUTHENTICATION"
+	// JobStepHealth는 cluster의 health를 가져온다.
+	JobStepHealth = "HEALTH"
+	// JobStepRegistration은 그 사이 같은 fsid가 등록되지 않았는지 다시 확인하고 cluster를 저장한다.
+	JobStepRegistration = "REGISTRATION"
+)
+
+type Job struct {
+	ID          string
+	Kind        string
+	ClusterID   string
+	State       string
+	Steps       []*JobStep
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+type JobStep struct {
+	Name       string
+	State      string
+	Message    string
+	StartTime  time.Time
+	FinishTime time.Time
+}
+
+func NewJob(job *domain.Job) *Job {
+	var steps []*JobStep
+	for _, step := range job.Steps() {
+		steps = append(steps, &JobStep{
+			Name:       step.Name(),
+			State:      string(step.State()),
+			Message:    step.Message(),
+			StartTime:  step.StartTime(),
+			FinishTime: step.FinishTime(),
+		})
+	}
+
+	return &Job{
+		ID:          job.ID(),
+		Kind:        string(job.Kind()),
+		ClusterID:   job.ClusterID(),
+		State:       string(job.State()),
+		Steps:       steps,
+		CreatedTime: job.CreatedTime(),
+		UpdatedTime: job.UpdatedTime(),
+	}
+}
+
+// IsSucceeded는 job이 성공으로 끝났는지 여부이다.
+func (j *Job) IsSucceeded() bool {
+	return j.State == string(domain.JobStateSucceeded)
+}
+
+// StartRegisterCluster는 cluster를 pending 상태로 저장하고, 접속을 확인할 job을 만든다.
+// 접속 확인은 RunRegisterJob으로 따로 진행한다.
+func (s *Service) StartRegisterCluster(ctx context.Context, registerCluster *RegisterCluster) (*Job, error) {
+	cluster, err := s.newCluster(registerCluster, domain.ClusterStatusPending)
+	if err != nil {
+		return nil, err
+	}
+
+	// fsid를 알면 접속해 보기 전에 중복 등록을 막는다.
+	if cluster.Fsid() != "" {
+		err = s.ensureFsidUnregistered(ctx, cluster.Fsid(), "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	job, err := domain.NewJob(
+		s.idGenerator.GenerateID(), domain.JobKindRegisterCluster, cluster.ID(), registerCluster.Actor,
+		domain.JobStatePending, nil, registerCluster.Now, registerCluster.Now,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	err = s.repository.CreateCluster(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	err = s.repository.CreateJob(ctx, job)
+	if err != nil {
+		s.deletePendingCluster(ctx, cluster.ID())
+
+		return nil, fmt.Errorf("failed to create job: %w", err)
+	}
+
+	return NewJob(job), nil
+}
+
+func (s *Service) GetJob(ctx context.Context, id string) (*Job, error) {
+	job, err := s.repository.GetJob(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	return NewJob(job), nil
+}
+
+// ListUnfinishedJobs는 끝나지 않은 job을 반환한다. 서버가 다시 시작했을 때 이어서 진행하는 데 쓴다.
+func (s *Service) ListUnfinishedJobs(ctx context.Context) ([]*Job, error) {
+	jobs, err := s.repository.ListJobs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	var ret []*Job
+
+	for _, job := range jobs {
+		if !job.State().IsFinished() {
+			ret = append(ret, NewJob(job))
+		}
+	}
+
+	return ret, nil
+}
+
+// RunRegisterJob은 pending cluster에 단계별로 접속해 보고 등록을 마친다.
+// 단계가 실패하면 pending cluster를 지우고 실패한 job을 반환한다. 오류는 job을 끝내지 못한 경우에만 반환한다.
+// 중간에 멈춘 job은 처음 단계부터 다시 진행한다. 단계의 결과는 등록할 때 한꺼번에 저장하므로 이어서 진행할 수 없다.
+func (s *Service) RunRegisterJob(ctx context.Context, id string) (*Job, error) {
+	job, err := s.repository.GetJob(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	if job.State().IsFinished() {
+		return NewJob(job), nil
+	}
+
+	run := &jobRun{
+		repository: s.repository,
+		job:        job,
+	}
+
+	err = run.restart(ctx)
+	if err != nil {
+		return s.abandonRegistration(ctx, run, job.ClusterID(), err)
+	}
+
+	cluster, err := s.repository.GetCluster(ctx, job.ClusterID())
+	if err != nil {
+		return s.abandonRegistration(ctx, run, job.ClusterID(), fmt.Errorf("failed to get cluster: %w", err))
+	}
+
+	registered, err := s.completeRegistration(ctx, run, cluster)
+	if err != nil {
+		return s.abandonRegistration(ctx, run, cluster.ID(), err)
+	}
+
+	// 등록한 cluster는 지우지 않는다. job을 끝내지 못했으면 다시 시작할 때 처음부터 확인하고 끝낸다.
+	err = run.succeed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordAudit(ctx, job.Actor(), registered.ID(), domain.AuditActionClusterRegistered,
+		"registered "+registered.Name(), nil, registered, run.job.UpdatedTime())
+	s.recordStatusChange(ctx, registered.ID(), cluster.Status(), registered.Status(), run.job.UpdatedTime())
+
+	return NewJob(run.job), nil
+}
+
+// abandonRegistration은 등록을 마치지 못한 job을 실패로 끝내고 pending cluster를 지운다.
+// job을 실패로 저장하지 못했으면 cause를 반환한다. 다시 시작하면 cluster가 없으므로 job도 실패로 끝난다.
+func (s *Service) abandonRegistration(ctx context.Context, run *jobRun, clusterID string, cause error) (*Job, error) {
+	s.logger.WarnContext(ctx, "failed to register cluster", "cluster_id", clusterID, "error", cause)
+
+	// 등록을 마치지 못한 cluster는 남기지 않는다. 다시 등록하면 된다.
+	s.deletePendingCluster(ctx, clusterID)
+
+	if !run.job.State().IsFinished() {
+		err := run.fail(ctx, cause.Error())
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to fail job", "job_id", run.job.ID(), "error", err)
+
+			return nil, cause
+		}
+	}
+
+	return NewJob(run.job), nil
+}
+
+// completeRegistration은 등록 job의 단계를 차례로 진행하고, 확인한 내용을 반영한 cluster를 저장한다.
+func (s *Service) completeRegistration(
+	ctx context.Context,
+	run *jobRun,
+	cluster *domain.Cluster,
+) (*domain.Cluster, error) {
+	err := run.step(ctx, JobStepConnectivity, func() (string, error) {
+		probes, err := s.prober.Probe(ctx, cluster.Hosts(), time.Now())
+		if err != nil {
+			return "", fmt.Errorf("failed to probe monitors: %w", err)
+		}
+
+		cluster, err = cluster.SetProbes(probes)
+		if err != nil {
+			return "", fmt.Errorf("failed to set probes: %w", err)
+		}
+
+		reachable := 0
+
+		for _, probe := range probes {
+			if probe.Reachable() {
+				reachable++
+			}
+		}
+
+		if reachable == 0 {
+			return "", fmt.Errorf("%w: no monitor accepted a connection", ErrClusterUnreachable)
+		}
+
+		return fmt.Sprintf("%d of %d monitors reachable", reachable, len(probes)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.factory.NewClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	defer client.Close()
+
+	err = run.step(ctx, JobStepAuthentication, func() (string, error) {
+		monitorMap, err := client.GetMonitorMap(ctx)
+		if err != nil {
+			return "", newUnreachableError("failed to get monitor map", err)
+		}
+
+		err = s.ensureFsidUnregistered(ctx, monitorMap.Fsid(), cluster.ID())
+		if err != nil {
+			return "", err
+		}
+
+		cluster, err = cluster.SetMonitorMap(monitorMap)
+		if err != nil {
+			return "", fmt.Errorf("failed to set monitor map: %w", err)
+		}
+
+		return fmt.Sprintf("authenticated as %s to fsid %s", cluster.Entity(), cluster.Fsid()), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = run.step(ctx, JobStepHealth, func() (string, error) {
+		now := time.Now()
+
+		status, detail, err := client.HealthCheck(ctx)
+		if err != nil {
+			return "", newUnreachableError("failed to health check", err)
+		}
+
+		cluster, err = cluster.SetStatus(status, detail, now)
+		if err != nil {
+			return "", fmt.Errorf("failed to set status: %w", err)
+		}
+
+		connection, err := domain.NewConnection(domain.ReachabilityReachable, "", now)
+		if err != nil {
+			return "", fmt.Errorf("failed to create connection: %w", err)
+		}
+
+		cluster, err = cluster.SetConnection(connection)
+		if err != nil {
+			return "", fmt.Errorf("failed to set connection: %w", err)
+		}
+
+		return string(status), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cluster = s.refreshVersions(ctx, client, cluster, time.Now())
+
+	err = run.step(ctx, JobStepRegistration, func() (string, error) {
+		err := s.saveRegistration(ctx, cluster, s.repository.UpdateCluster)
+		if err != nil {
+			return "", fmt.Errorf("failed to update cluster: %w", err)
+		}
+
+		return "registered as " + cluster.Name(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// deletePendingCluster는 등록을 마치지 못한 cluster를 지운다. 실패해도 기록만 남긴다.
+// 이미 등록을 마친 cluster나 없는 cluster는 그대로 둔다.
+func (s *Service) deletePendingCluster(ctx context.Context, id string) {
+	cluster, err := s.repository.GetCluster(ctx, id)
+	if errors.Is(err, repository.ErrClusterNotFound) {
+		return
+	}
+
+	if err == nil && cluster.Status() != domain.ClusterStatusPending {
+		return
+	}
+
+	err = s.repository.DeleteCluster(ctx, id)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to delete pending cluster", "cluster_id", id, "error", err)
+	}
+}
+
+// jobRun은 진행 중인 job의 단계를 실행하고, 단계가 바뀔 때마다 저장한다.
+type jobRun struct {
+	repository repository.Repository
+	job        *domain.Job
+}
+
+// step은 단계 하나를 실행한다. fn이 반환한 message나 오류를 단계의 결과로 남긴다.
+// fn이 실패하면 job도 실패로 끝나고, fn의 오류를 반환한다.
+func (r *jobRun) step(ctx context.Context, name string, fn func() (string, error)) error {
+	job, err := r.job.StartStep(name, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to start step %s: %w", name, err)
+	}
+
+	err = r.save(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	message, stepErr := fn()
+	if stepErr != nil {
+		job, err = r.job.FailStep(stepErr.Error(), time.Now())
+	} else {
+		job, err = r.job.FinishStep(message, time.Now())
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to finish step %s: %w", name, err)
+	}
+
+	err = r.save(ctx, job)
+	if err != nil {
+		return err
+	}
+
+	return stepErr
+}
+
+// restart는 중간에 멈춘 job의 단계를 비우고 저장한다.
+func (r *jobRun) restart(ctx context.Context) error {
+	job, err := r.job.Restart(time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to restart job: %w", err)
+	}
+
+	if job == r.job {
+		return nil
+	}
+
+	return r.save(ctx, job)
+}
+
+// fail은 job을 실패로 끝낸다. 진행 중인 단계가 없으면 등록 단계의 실패로 남긴다.
+func (r *jobRun) fail(ctx context.Context, message string) error {
+	job, err := r.job.Fail(JobStepRegistration, message, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to fail job: %w", err)
+	}
+
+	return r.save(ctx, job)
+}
+
+func (r *jobRun) succeed(ctx context.Context) error {
+	job, err := r.job.Succeed(time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to finish job: %w", err)
+	}
+
+	return r.save(ctx, job)
+}
+
+func (r *jobRun) save(ctx context.Context, job *domain.Job) error {
+	err := r.repository.UpdateJob(ctx, job)
+	if err != nil {
+		return fmt.Errorf("failed to update job: %w", err)
+	}
+
+	r.job = job
+
+	return nil
+}
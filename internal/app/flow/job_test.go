@@ -0,0 +1,214 @@
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/app/flow"
+	"github.com/neatflowcv/cepher/internal/pkg/client/core"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/idgenerator/ulid"
+	"github.com/neatflowcv/cepher/internal/pkg/repository"
+	"github.com/neatflowcv/cepher/internal/pkg/repository/file"
+	"github.com/neatflowcv/cepher/pkg/cephcli"
+)
+
+const monDump = `{"epoch":1,"fsid":"6f1c9a2e-4b7d-11ef-9c3a-525400a1b2c3","min_mon_release":19,` +
+	`"min_mon_release_name":"squid","mons":[{"rank":0,"name":"a","public_addrs":{"addrvec":` +
+	`[{"type":"v1","addr":"192.168.10.11:6789","nonce":0}]}}],"quorum":[0]}`
+
+// createCluster는 monitor 하나인 cluster-1을 status로 저장한다.
+func createCluster(t *testing.T, repo repository.Repository, status domain.ClusterStatus, now time.Time) {
+	t.Helper()
+
+	hosts, err := domain.NewAddressesFromHosts([]string{"v1:192.168.10.11:6789"})
+	if err != nil {
+		t.Fatalf("failed to create hosts: %v", err)
+	}
+
+	cluster, err := domain.NewCluster(
+		"cluster-1", "test", "", hosts, nil, false, "client.admin", "AQ==",
+		nil, status, now, "", domain.NewUnknownConnection(), nil, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create cluster: %v", err)
+	}
+
+	err = repo.CreateCluster(t.Context(), cluster)
+	if err != nil {
+		t.Fatalf("CreateCluster() error = %v", err)
+	}
+}
+
+// fakeProber는 모든 monitor가 reachable 값대로 응답한 결과를 돌려준다.
+type fakeProber struct {
+	reachable bool
+}
+
+func (p *fakeProber) Probe(_ context.Context, addresses []*domain.Address, now time.Time) ([]*domain.MonitorProbe, error) {
+	var ret []*domain.MonitorProbe
+
+	for _, address := range addresses {
+		lastError := ""
+		if !p.reachable {
+			lastError = "connection refused"
+		}
+
+		probe, err := domain.NewMonitorProbe(address, p.reachable, time.Millisecond, lastError, now)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		ret = append(ret, probe)
+	}
+
+	return ret, nil
+}
+
+func TestService_RunRegisterJob_Resume(t *testing.T) {
+	t.Parallel()
+
+	allSteps := []string{
+		flow.JobStepConnectivity + " SUCCEEDED",
+		flow.JobStepAuthentication + " SUCCEEDED",
+		flow.JobStepHealth + " SUCCEEDED",
+		flow.JobStepRegistration + " SUCCEEDED",
+	}
+
+	tests := []struct {
+		name        string
+		steps       []string
+		running     bool
+		noCluster   bool
+		unreachable bool
+		wantState   string
+		wantSteps   []string
+		wantCluster bool
+	}{
+		{
+			name:        "not started",
+			wantState:   string(domain.JobStateSucceeded),
+			wantSteps:   allSteps,
+			wantCluster: true,
+		},
+		{
+			name:        "interrupted mid step",
+			steps:       []string{flow.JobStepConnectivity, flow.JobStepAuthentication},
+			running:     true,
+			wantState:   string(domain.JobStateSucceeded),
+			wantSteps:   allSteps,
+			wantCluster: true,
+		},
+		{
+			name:        "interrupted between steps",
+			steps:       []string{flow.JobStepConnectivity},
+			wantState:   string(domain.JobStateSucceeded),
+			wantSteps:   allSteps,
+			wantCluster: true,
+		},
+		{
+			name:        "pending cluster is gone",
+			steps:       []string{flow.JobStepConnectivity, flow.JobStepAuthentication},
+			running:     true,
+			noCluster:   true,
+			wantState:   string(domain.JobStateFailed),
+			wantSteps:   []string{flow.JobStepRegistration + " FAILED"},
+			wantCluster: false,
+		},
+		{
+			name:        "unreachable after resume",
+			steps:       []string{flow.JobStepConnectivity},
+			unreachable: true,
+			wantState:   string(domain.JobStateFailed),
+			wantSteps:   []string{flow.JobStepConnectivity + " FAILED"},
+			wantCluster: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			runner := cephcli.NewFakeRunner()
+			runner.SetOutput([]byte(monDump), "mon", "dump")
+			runner.SetOutput([]byte(`{"status":"HEALTH_OK"}`), "health", "detail")
+
+			factory := core.NewFactory(runner, logger)
+			t.Cleanup(factory.Close)
+
+			repo := file.NewRepository(t.TempDir(), logger)
+			service := flow.NewService(ulid.NewGenerator(), factory, repo, &fakeProber{reachable: !tt.unreachable}, logger)
+
+			now := time.Now()
+
+			if !tt.noCluster {
+				createCluster(t, repo, domain.ClusterStatusPending, now)
+			}
+
+			var steps []*domain.JobStep
+
+			for i, name := range tt.steps {
+				state, finishTime := domain.JobStateSucceeded, now
+				if tt.running && i == len(tt.steps)-1 {
+					state, finishTime = domain.JobStateRunning, time.Time{}
+				}
+
+				step, err := domain.NewJobStep(name, state, "", now, finishTime)
+				if err != nil {
+					t.Fatalf("failed to create step: %v", err)
+				}
+
+				steps = append(steps, step)
+			}
+
+			state := domain.JobStatePending
+			if len(steps) > 0 {
+				state = domain.JobStateRunning
+			}
+
+			job, err := domain.NewJob("job-1", domain.JobKindRegisterCluster, "cluster-1", "admin", state, steps, now, now)
+			if err != nil {
+				t.Fatalf("failed to create job: %v", err)
+			}
+
+			err = repo.CreateJob(t.Context(), job)
+			if err != nil {
+				t.Fatalf("CreateJob() error = %v", err)
+			}
+
+			got, err := service.RunRegisterJob(t.Context(), job.ID())
+			if err != nil {
+				t.Fatalf("RunRegisterJob() error = %v", err)
+			}
+
+			if got.State != tt.wantState {
+				t.Errorf("state = %q, want %q", got.State, tt.wantState)
+			}
+
+			var summary []string
+			for _, step := range got.Steps {
+				summary = append(summary, step.Name+" "+step.State)
+			}
+
+			if !slices.Equal(summary, tt.wantSteps) {
+				t.Errorf("steps = %v, want %v", summary, tt.wantSteps)
+			}
+
+			_, err = repo.GetCluster(t.Context(), "cluster-1")
+			if tt.wantCluster && err != nil {
+				t.Errorf("GetCluster() error = %v", err)
+			}
+
+			if !tt.wantCluster && !errors.Is(err, repository.ErrClusterNotFound) {
+				t.Errorf("GetCluster() error = %v, want %v", err, repository.ErrClusterNotFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package flow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type UpdateCluster struct {
+	// Labels가 nil이 아니면 label을 모두 이 값으로 바꾼다. 빈 map이면 label을 모두 지운다.
+	Labels map[string]string
+	// Actor는 바꾼 사람이다. audit log에 남긴다.
+	Actor string
+	Now   time.Time
+}
+
+// UpdateCluster는 접속과 관계없는 cluster 설정을 바꾼다.
+func (s *Service) UpdateCluster(ctx context.Context, id string, updateCluster *UpdateCluster) (*Cluster, error) {
+	cluster, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	changed := cluster
+
+	if updateCluster.Labels != nil {
+		changed, err = changed.SetLabels(updateCluster.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set labels: %w", err)
+		}
+	}
+
+	if changed == cluster {
+		return NewCluster(cluster, updateCluster.Now), nil
+	}
+
+	err = s.repository.UpdateCluster(ctx, changed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update cluster: %w", err)
+	}
+
+	s.recordAudit(ctx, updateCluster.Actor, id, domain.AuditActionClusterUpdated,
+		"cluster updated", cluster, changed, updateCluster.Now)
+
+	return NewCluster(changed, updateCluster.Now), nil
+}
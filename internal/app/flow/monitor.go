@@ -0,0 +1,77 @@
+package flow
+
+import (
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type MonitorMap struct {
+	Epoch    int
+	Monitors []*Monitor
+}
+
+type Monitor struct {
+	Name     string
+	Rank     int
+	Addrs    []*MonitorAddress
+	InQuorum bool
+}
+
+type MonitorAddress struct {
+	Protocol string
+	Addr     string
+}
+
+func NewMonitorMap(monitorMap *domain.MonitorMap) *MonitorMap {
+	if monitorMap == nil {
+		return nil
+	}
+
+	var monitors []*Monitor
+
+	for _, monitor := range monitorMap.Monitors() {
+		var addrs []*MonitorAddress
+		for _, addr := range monitor.Addrs() {
+			addrs = append(addrs, &MonitorAddress{
+				Protocol: addr.Protocol(),
+				Addr:     addr.Addr(),
+			})
+		}
+
+		monitors = append(monitors, &Monitor{
+			Name:     monitor.Name(),
+			Rank:     monitor.Rank(),
+			Addrs:    addrs,
+			InQuorum: monitor.InQuorum(),
+		})
+	}
+
+	return &MonitorMap{
+		Epoch:    monitorMap.Epoch(),
+		Monitors: monitors,
+	}
+}
+
+type MonitorProbe struct {
+	Address    string
+	Reachable  bool
+	Latency    time.Duration
+	LastError  string
+	ProbedTime time.Time
+}
+
+func NewMonitorProbes(probes []*domain.MonitorProbe) []*MonitorProbe {
+	var ret []*MonitorProbe
+	for _, probe := range probes {
+		ret = append(ret, &MonitorProbe{
+			Address:    probe.Address().String(),
+			Reachable:  probe.Reachable(),
+			Latency:    probe.Latency(),
+			LastError:  probe.LastError(),
+			ProbedTime: probe.ProbedTime(),
+		})
+	}
+
+	return ret
+}
@@ -0,0 +1,36 @@
+package flow
+
+import "github.com/neatflowcv/cepher/internal/pkg/domain"
+
+type OSD struct {
+	ID          int
+	Name        string
+	Host        string
+	DeviceClass string
+	Up          bool
+	In          bool
+	CrushWeight float64
+	Reweight    float64
+	Utilization float64
+	PGs         int
+}
+
+func NewOSDs(osds []*domain.OSD) []*OSD {
+	var ret []*OSD
+	for _, osd := range osds {
+		ret = append(ret, &OSD{
+			ID:          osd.ID(),
+			Name:        osd.Name(),
+			Host:        osd.Host(),
+			DeviceClass: osd.DeviceClass(),
+			Up:          osd.IsUp(),
+			In:          osd.IsIn(),
+			CrushWeight: osd.CrushWeight(),
+			Reweight:    osd.Reweight(),
+			Utilization: osd.Utilization(),
+			PGs:         osd.PGs(),
+		})
+	}
+
+	return ret
+}
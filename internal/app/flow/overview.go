@@ -0,0 +1,45 @@
+package flow
+
+import (
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type Overview struct {
+	CollectedTime    time.Time
+	TotalBytes       int64
+	UsedBytes        int64
+	AvailBytes       int64
+	OSDs             int
+	UpOSDs           int
+	InOSDs           int
+	PGs              int
+	PGStates         map[string]int
+	PoolCount        int
+	ReadBytesPerSec  int64
+	WriteBytesPerSec int64
+	ReadOpsPerSec    int64
+	WriteOpsPerSec   int64
+	Quorum           []string
+}
+
+func NewOverview(overview *domain.Overview) *Overview {
+	return &Overview{
+		CollectedTime:    overview.CollectedTime(),
+		TotalBytes:       overview.Capacity().TotalBytes(),
+		UsedBytes:        overview.Capacity().UsedBytes(),
+		AvailBytes:       overview.Capacity().AvailBytes(),
+		OSDs:             overview.OSDs().Total(),
+		UpOSDs:           overview.OSDs().Up(),
+		InOSDs:           overview.OSDs().In(),
+		PGs:              overview.PGs().Total(),
+		PGStates:         overview.PGs().States(),
+		PoolCount:        overview.PoolCount(),
+		ReadBytesPerSec:  overview.ClientIO().ReadBytesPerSec(),
+		WriteBytesPerSec: overview.ClientIO().WriteBytesPerSec(),
+		ReadOpsPerSec:    overview.ClientIO().ReadOpsPerSec(),
+		WriteOpsPerSec:   overview.ClientIO().WriteOpsPerSec(),
+		Quorum:           overview.Quorum(),
+	}
+}
@@ -0,0 +1,21 @@
+package flow
+
+import "github.com/neatflowcv/cepher/internal/pkg/client"
+
+type ClientPoolStats struct {
+	Sessions      int
+	Hits          uint64
+	Misses        uint64
+	Invalidations uint64
+	Evictions     uint64
+}
+
+func NewClientPoolStats(stats client.PoolStats) *ClientPoolStats {
+	return &ClientPoolStats{
+		Sessions:      stats.Sessions,
+		Hits:          stats.Hits,
+		Misses:        stats.Misses,
+		Invalidations: stats.Invalidations,
+		Evictions:     stats.Evictions,
+	}
+}
@@ -0,0 +1,163 @@
+package flow
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+// 목록을 정렬할 때 쓰는 항목이다. 앞에 "-"를 붙이면 역순이다.
+const (
+	ClusterSortID          = "id"
+	ClusterSortName        = "name"
+	ClusterSortStatus      = "status"
+	ClusterSortLastContact = "last_contact"
+)
+
+type ClusterQuery struct {
+	// Selector는 "env=prod,team in (a,b)" 같은 label 조건이다. 비어 있으면 모든 cluster이다.
+	Selector string
+	// Statuses 중 하나인 cluster만 고른다. 비어 있으면 status로 고르지 않는다.
+	Statuses []string
+	// Name은 이름에 포함되어야 하는 문자열이다. 대소문자를 구분하지 않는다.
+	Name string
+	// Sort는 ClusterSort 중 하나이다. 비어 있으면 name 순이다.
+	Sort   string
+	Offset int
+	// Limit은 반환할 최대 개수이다. 0이면 모두 반환한다.
+	Limit int
+	Now   time.Time
+}
+
+// SearchClusters는 조건에 맞는 cluster를 정렬해서 offset부터 limit개 반환한다.
+// 두 번째 반환값은 offset과 limit을 적용하기 전의 개수이다.
+func (s *Service) SearchClusters(ctx context.Context, query *ClusterQuery) ([]*Cluster, int, error) {
+	selector, err := domain.ParseLabelSelector(query.Selector)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse selector: %w", err)
+	}
+
+	var statuses []domain.ClusterStatus
+
+	for _, value := range query.Statuses {
+		status, err := domain.NewClusterStatus(value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to create cluster status: %w", err)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	compare, err := clusterComparer(query.Sort)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if query.Offset < 0 {
+		return nil, 0, domain.InvalidParameterError("offset")
+	}
+
+	if query.Limit < 0 {
+		return nil, 0, domain.InvalidParameterError("limit")
+	}
+
+	clusters, err := s.repository.ListClusters(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	name := strings.ToLower(query.Name)
+
+	var matched []*domain.Cluster
+
+	for _, cluster := range clusters {
+		if !selector.Matches(cluster.Labels()) {
+			continue
+		}
+
+		if len(statuses) > 0 && !slices.Contains(statuses, cluster.Status()) {
+			continue
+		}
+
+		if !strings.Contains(strings.ToLower(cluster.Name()), name) {
+			continue
+		}
+
+		matched = append(matched, cluster)
+	}
+
+	slices.SortStableFunc(matched, compare)
+
+	total := len(matched)
+	matched = matched[min(query.Offset, total):]
+
+	if query.Limit > 0 && len(matched) > query.Limit {
+		matched = matched[:query.Limit]
+	}
+
+	return NewClusters(matched, query.Now), total, nil
+}
+
+// clusterComparer는 sort에 맞는 비교 함수를 반환한다. 같으면 이름, id 순이다.
+func clusterComparer(sort string) (func(a, b *domain.Cluster) int, error) {
+	key, descending := strings.CutPrefix(sort, "-")
+
+	var compare func(a, b *domain.Cluster) int
+
+	switch key {
+	case "", ClusterSortName:
+		compare = compareClusterNames
+	case ClusterSortID:
+		compare = func(a, b *domain.Cluster) int {
+			return cmp.Compare(a.ID(), b.ID())
+		}
+	case ClusterSortStatus:
+		compare = func(a, b *domain.Cluster) int {
+			return cmp.Compare(statusRank(a.Status()), statusRank(b.Status()))
+		}
+	case ClusterSortLastContact:
+		compare = func(a, b *domain.Cluster) int {
+			return a.Connection().LastContactTime().Compare(b.Connection().LastContactTime())
+		}
+	default:
+		return nil, domain.InvalidParameterError("sort")
+	}
+
+	return func(a, b *domain.Cluster) int {
+		ret := compare(a, b)
+		if descending {
+			ret = -ret
+		}
+
+		return cmp.Or(ret, compareClusterNames(a, b), cmp.Compare(a.ID(), b.ID()))
+	}, nil
+}
+
+// compareClusterNames는 대소문자를 구분하지 않고 이름을 비교한다.
+func compareClusterNames(a, b *domain.Cluster) int {
+	return cmp.Or(
+		cmp.Compare(strings.ToLower(a.Name()), strings.ToLower(b.Name())),
+		cmp.Compare(a.Name(), b.Name()),
+	)
+}
+
+// statusRank는 status를 정렬할 때의 순서이다. 좋은 상태가 앞에 온다.
+func statusRank(status domain.ClusterStatus) int {
+	switch status {
+	case domain.ClusterStatusHealthOK:
+		return 0
+	case domain.ClusterStatusPending:
+		return 1
+	case domain.ClusterStatusUnknown:
+		return 2 //nolint:mnd
+	case domain.ClusterStatusHealthWarning:
+		return 3 //nolint:mnd
+	default:
+		return 4 //nolint:mnd
+	}
+}
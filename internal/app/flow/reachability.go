@@ -0,0 +1,34 @@
+package flow
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/neatflowcv/cepher/internal/pkg/client"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+func newReachability(err error) domain.Reachability {
+	switch {
+	case errors.Is(err, client.ErrAuthFailure):
+		return domain.ReachabilityAuthFailure
+	case errors.Is(err, client.ErrTimeout):
+		return domain.ReachabilityTimeout
+	case errors.Is(err, client.ErrQuorumLost):
+		return domain.ReachabilityQuorumLost
+	case errors.Is(err, client.ErrRuntime):
+		return domain.ReachabilityRuntimeError
+	default:
+		return domain.ReachabilityUnreachable
+	}
+}
+
+// newUnreachableError는 cluster에 접근하지 못한 오류를 ErrClusterUnreachable로 감싼다.
+// container runtime 오류는 cluster가 아니라 cepher 쪽 문제이므로 감싸지 않는다.
+func newUnreachableError(message string, err error) error {
+	if errors.Is(err, client.ErrRuntime) {
+		return fmt.Errorf("%s: %w", message, err)
+	}
+
+	return fmt.Errorf("%w: %s: %w", ErrClusterUnreachable, message, err)
+}
@@ -2,13 +2,16 @@ package flow
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/neatflowcv/cepher/internal/pkg/client"
 	"github.com/neatflowcv/cepher/internal/pkg/domain"
 	"github.com/neatflowcv/cepher/internal/pkg/idgenerator"
+	"github.com/neatflowcv/cepher/internal/pkg/prober"
 	"github.com/neatflowcv/cepher/internal/pkg/repository"
 )
 
@@ -16,31 +19,35 @@ type Service struct {
 	idGenerator idgenerator.Generator
 	factory     client.Factory
 	repository  repository.Repository
+	prober      prober.Prober
+	logger      *slog.Logger
+
+	// recorded는 status 변경 기록이 있다고 확인한 cluster ID이다. refresh마다 기록을 읽지 않도록 기억한다.
+	recorded sync.Map
+	// registrationMu는 fsid 중복 확인과 등록한 cluster의 저장을 묶는다.
+	registrationMu sync.Mutex
 }
 
-func NewService(idGenerator idgenerator.Generator, factory client.Factory, repository repository.Repository) *Service {
+func NewService(
+	idGenerator idgenerator.Generator,
+	factory client.Factory,
+	repository repository.Repository,
+	prober prober.Prober,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
 		idGenerator: idGenerator,
 		factory:     factory,
 		repository:  repository,
+		prober:      prober,
+		logger:      logger,
 	}
 }
 
 func (s *Service) RegisterCluster(ctx context.Context, registerCluster *RegisterCluster) (*Cluster, error) {
-	id := s.idGenerator.GenerateID()
-
-	addresses, err := domain.NewAddressesFromHosts(registerCluster.Hosts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create domain addresses: %w", err)
-	}
-
-	cluster, err := domain.NewCluster(
-		id, registerCluster.Name, addresses, registerCluster.Key,
-		domain.ClusterStatusUnknown, registerCluster.Now,
-		"",
-	)
+	cluster, err := s.newCluster(registerCluster, domain.ClusterStatusUnknown)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cluster: %w", err)
+		return nil, err
 	}
 
 	client, err := s.factory.NewClient(ctx, cluster)
@@ -51,7 +58,7 @@ func (s *Service) RegisterCluster(ctx context.Context, registerCluster *Register
 
 	status, detail, err := client.HealthCheck(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to health check: %w", err)
+		return nil, newUnreachableError("failed to health check", err)
 	}
 
 	cluster, err = cluster.SetStatus(status, detail, registerCluster.Now)
@@ -59,37 +66,139 @@ func (s *Service) RegisterCluster(ctx context.Context, registerCluster *Register
 		return nil, fmt.Errorf("failed to set status: %w", err)
 	}
 
-	err = s.repository.CreateCluster(ctx, cluster)
+	connection, err := domain.NewConnection(domain.ReachabilityReachable, "", registerCluster.Now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection: %w", err)
+	}
+
+	cluster, err = cluster.SetConnection(connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set connection: %w", err)
+	}
+
+	monitorMap, err := client.GetMonitorMap(ctx)
+	if err != nil {
+		return nil, newUnreachableError("failed to get monitor map", err)
+	}
+
+	err = s.ensureFsidUnregistered(ctx, monitorMap.Fsid(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err = cluster.SetMonitorMap(monitorMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set monitor map: %w", err)
+	}
+
+	cluster = s.refreshVersions(ctx, client, cluster, registerCluster.Now)
+
+	err = s.saveRegistration(ctx, cluster, s.repository.CreateCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	s.recordAudit(ctx, registerCluster.Actor, cluster.ID(), domain.AuditActionClusterRegistered,
+		"registered "+cluster.Name(), nil, cluster, registerCluster.Now)
+	s.recordStatusChange(ctx, cluster.ID(), domain.ClusterStatusUnknown, cluster.Status(), registerCluster.Now)
+
+	return NewCluster(cluster, registerCluster.Now), nil
+}
+
+// newCluster는 등록 요청으로 아직 저장하지 않은 cluster를 만든다.
+func (s *Service) newCluster(registerCluster *RegisterCluster, status domain.ClusterStatus) (*domain.Cluster, error) {
+	addresses, err := domain.NewAddressesFromHosts(registerCluster.Hosts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain addresses: %w", err)
+	}
+
+	entity := registerCluster.Entity
+	if entity == "" {
+		entity = domain.DefaultEntity
+	}
+
+	cluster, err := domain.NewCluster(
+		s.idGenerator.GenerateID(), registerCluster.Name, registerCluster.Fsid, addresses, nil, false,
+		entity, registerCluster.Key, registerCluster.Labels, status, registerCluster.Now,
+		"", domain.NewUnknownConnection(), nil, nil, nil,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cluster: %w", err)
 	}
 
-	return &Cluster{
-		ID:       cluster.ID(),
-		Name:     cluster.Name(),
-		Status:   string(cluster.Status()),
-		IsStable: domain.IsClusterStable(cluster, registerCluster.Now),
-		Detail:   cluster.Detail(),
-	}, nil
+	return cluster, nil
+}
+
+// ensureFsidUnregistered는 같은 fsid의 cluster가 이미 등록되어 있으면 오류를 반환한다.
+// exceptID의 cluster는 등록 중인 cluster 자신이므로 비교하지 않는다.
+func (s *Service) ensureFsidUnregistered(ctx context.Context, fsid string, exceptID string) error {
+	clusters, err := s.repository.ListClusters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		if cluster.ID() != exceptID && cluster.Fsid() == fsid {
+			return fmt.Errorf("%w: fsid %s is registered as %s", repository.ErrClusterAlreadyExists, fsid, cluster.ID())
+		}
+	}
+
+	return nil
+}
+
+// saveRegistration은 같은 fsid가 등록되지 않았는지 다시 확인하고 save로 cluster를 저장한다.
+// 앞서 확인한 뒤 cluster에 접속하는 동안 다른 등록이 같은 fsid를 먼저 저장할 수 있으므로 확인과 저장 사이에 끼어들지 못하게 한다.
+// fsid를 모르는 cluster는 중복을 확인할 수 없으므로 그대로 저장한다.
+func (s *Service) saveRegistration(
+	ctx context.Context,
+	cluster *domain.Cluster,
+	save func(context.Context, *domain.Cluster) error,
+) error {
+	s.registrationMu.Lock()
+	defer s.registrationMu.Unlock()
+
+	if cluster.Fsid() == "" {
+		return save(ctx, cluster)
+	}
+
+	err := s.ensureFsidUnregistered(ctx, cluster.Fsid(), cluster.ID())
+	if err != nil {
+		return err
+	}
+
+	return save(ctx, cluster)
 }
 
-func (s *Service) ListClusters(ctx context.Context) ([]*Cluster, error) {
+func (s *Service) ListClusters(ctx context.Context, now time.Time) ([]*Cluster, error) {
 	clusters, err := s.repository.ListClusters(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list clusters: %w", err)
 	}
 
-	return NewClusters(clusters), nil
+	return NewClusters(clusters, now), nil
 }
 
 // RefreshCluster refreshes the cluster status
 // returns true if the cluster status is ok.
 func (s *Service) RefreshCluster(ctx context.Context, id string, now time.Time) (bool, error) {
-	cluster, err := s.repository.GetCluster(ctx, id)
+	stored, err := s.repository.GetCluster(ctx, id)
 	if err != nil {
 		return false, fmt.Errorf("failed to get cluster: %w", err)
 	}
 
+	s.recordStatusBaseline(ctx, stored, now)
+
+	// client가 응답하는 monitor부터 사용하도록 접속 전에 monitor마다 확인한다.
+	probes, err := s.prober.Probe(ctx, stored.Hosts(), now)
+	if err != nil {
+		return false, fmt.Errorf("failed to probe monitors: %w", err)
+	}
+
+	cluster, err := stored.SetProbes(probes)
+	if err != nil {
+		return false, fmt.Errorf("failed to set probes: %w", err)
+	}
+
 	client, err := s.factory.NewClient(ctx, cluster)
 	if err != nil {
 		return false, fmt.Errorf("failed to create client: %w", err)
@@ -98,13 +207,26 @@ func (s *Service) RefreshCluster(ctx context.Context, id string, now time.Time)
 
 	var changedCluster *domain.Cluster
 
+	connection, err := domain.NewConnection(domain.ReachabilityReachable, "", now)
+	if err != nil {
+		return false, fmt.Errorf("failed to create connection: %w", err)
+	}
+
 	status, detail, err := client.HealthCheck(ctx)
 	if err != nil {
 		// client로 부터 상태를 가져오지 못하면, 상태를 Unknown으로 설정하고 계속 진행한다.
-		log.Printf("failed to health check cluster %s: %v", id, err)
+		// 접근하지 못한 이유는 connection에 남긴다.
+		s.logger.WarnContext(ctx, "failed to health check", "cluster_id", id, "error", err)
 
 		status = domain.ClusterStatusUnknown
 		detail = ""
+
+		connection, err = domain.NewConnection(
+			newReachability(err), err.Error(), cluster.Connection().LastContactTime(),
+		)
+		if err != nil {
+			return false, fmt.Errorf("failed to create connection: %w", err)
+		}
 	}
 
 	changedCluster, err = cluster.SetStatus(status, detail, now)
@@ -112,7 +234,32 @@ func (s *Service) RefreshCluster(ctx context.Context, id string, now time.Time)
 		return false, fmt.Errorf("failed to set status: %w", err)
 	}
 
-	if cluster == changedCluster {
+	if connection.IsReachable() {
+		changedCluster, err = s.refreshMonitorMap(ctx, client, changedCluster, now)
+		if err != nil {
+			changedCluster, err = s.flagIdentityMismatch(ctx, changedCluster, err, now)
+			if err != nil {
+				return false, fmt.Errorf("failed to flag identity mismatch: %w", err)
+			}
+
+			connection = changedCluster.Connection()
+		} else {
+			changedCluster = s.refreshVersions(ctx, client, changedCluster, now)
+		}
+	}
+
+	changedCluster, err = changedCluster.SetConnection(connection)
+	if err != nil {
+		return false, fmt.Errorf("failed to set connection: %w", err)
+	}
+
+	if changedCluster.Connection().IsReachable() {
+		s.refreshOverview(ctx, client, id, now)
+	} else if canRecoverByHosts(changedCluster) {
+		changedCluster = s.rollbackHosts(ctx, changedCluster, now)
+	}
+
+	if stored == changedCluster {
 		return changedCluster.IsOK(), nil
 	}
 
@@ -121,10 +268,293 @@ func (s *Service) RefreshCluster(ctx context.Context, id string, now time.Time)
 		return false, fmt.Errorf("failed to update cluster: %w", err)
 	}
 
+	s.recordStatusChange(ctx, id, stored.Status(), changedCluster.Status(), now)
+
 	return changedCluster.IsOK(), nil
 }
 
-func (s *Service) UpdateMonitor(ctx context.Context, id string) error {
+// refreshOverview는 overview snapshot을 갱신한다.
+// overview는 부가 정보이므로 실패해도 refresh를 중단하지 않는다.
+func (s *Service) refreshOverview(ctx context.Context, client client.Client, id string, now time.Time) {
+	overview, err := client.GetOverview(ctx, now)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to get overview", "cluster_id", id, "error", err)
+
+		return
+	}
+
+	err = s.repository.UpsertOverview(ctx, overview)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to upsert overview", "cluster_id", id, "error", err)
+	}
+}
+
+// refreshMonitorMap은 monmap을 갱신한다.
+// 다른 cluster에 접근한 경우에만 오류를 반환하고, 그 외의 실패는 cluster를 그대로 반환한다.
+func (s *Service) refreshMonitorMap(
+	ctx context.Context,
+	client client.Client,
+	cluster *domain.Cluster,
+	now time.Time,
+) (*domain.Cluster, error) {
+	monitorMap, err := client.GetMonitorMap(ctx)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to get monitor map", "cluster_id", cluster.ID(), "error", err)
+
+		return cluster, nil
+	}
+
+	changed, err := s.applyMonitorMap(ctx, cluster, monitorMap, now)
+	if err != nil {
+		if errors.Is(err, domain.ErrIdentityMismatch) {
+			return nil, err
+		}
+
+		s.logger.WarnContext(ctx, "failed to apply monitor map", "cluster_id", cluster.ID(), "error", err)
+
+		return cluster, nil
+	}
+
+	return changed, nil
+}
+
+// refreshVersions는 daemon 버전과 release를 갱신하고, release가 바뀌면 event로 남긴다.
+// 버전은 부가 정보이므로 실패해도 cluster를 그대로 반환한다.
+func (s *Service) refreshVersions(
+	ctx context.Context,
+	client client.Client,
+	cluster *domain.Cluster,
+	now time.Time,
+) *domain.Cluster {
+	versions, err := client.GetVersions(ctx, cluster.MonitorMap())
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to get versions", "cluster_id", cluster.ID(), "error", err)
+
+		return cluster
+	}
+
+	before := cluster.Release()
+
+	changed, err := cluster.SetVersions(versions)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to set versions", "cluster_id", cluster.ID(), "error", err)
+
+		return cluster
+	}
+
+	after := changed.Release()
+	if before != domain.ReleaseUnknown && after != domain.ReleaseUnknown && before != after {
+		s.recordEvent(ctx, cluster.ID(), domain.EventKindReleaseChanged,
+			fmt.Sprintf("release changed from %s to %s", before, after), now)
+	}
+
+	return changed
+}
+
+// flagIdentityMismatch는 등록된 것과 다른 cluster에 접근했음을 cluster에 남긴다.
+// 다른 cluster의 health는 의미가 없으므로 status는 Unknown으로 둔다.
+func (s *Service) flagIdentityMismatch(
+	ctx context.Context,
+	cluster *domain.Cluster,
+	cause error,
+	now time.Time,
+) (*domain.Cluster, error) {
+	s.logger.ErrorContext(ctx, "IDENTITY MISMATCH: cluster answered as a different cluster",
+		"cluster_id", cluster.ID(), "cluster_name", cluster.Name(), "error", cause)
+
+	if cluster.Connection().Reachability() != domain.ReachabilityIdentityMismatch {
+		s.recordEvent(ctx, cluster.ID(), domain.EventKindIdentityMismatch, cause.Error(), now)
+	}
+
+	connection, err := domain.NewConnection(
+		domain.ReachabilityIdentityMismatch, cause.Error(), cluster.Connection().LastContactTime(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create connection: %w", err)
+	}
+
+	changed, err := cluster.SetStatus(domain.ClusterStatusUnknown, "", now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set status: %w", err)
+	}
+
+	changed, err = changed.SetConnection(connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set connection: %w", err)
+	}
+
+	return changed, nil
+}
+
+// applyMonitorMap은 monmap을 cluster에 반영하고, 그 사이의 변화를 event로 남긴다.
+func (s *Service) applyMonitorMap(
+	ctx context.Context,
+	cluster *domain.Cluster,
+	monitorMap *domain.MonitorMap,
+	now time.Time,
+) (*domain.Cluster, error) {
+	changes := domain.CompareMonitorMaps(cluster.MonitorMap(), monitorMap)
+
+	changed, err := cluster.SetMonitorMap(monitorMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set monitor map: %w", err)
+	}
+
+	for _, change := range changes {
+		s.recordEvent(ctx, cluster.ID(), change.Kind(), change.Message(), now)
+	}
+
+	return changed, nil
+}
+
+// recordEvent는 event를 저장한다. event 저장 실패로 본래 작업을 중단하지 않는다.
+func (s *Service) recordEvent(
+	ctx context.Context,
+	clusterID string,
+	kind domain.EventKind,
+	message string,
+	now time.Time,
+) {
+	s.logger.InfoContext(ctx, "event", "cluster_id", clusterID, "kind", kind, "message", message)
+
+	event, err := domain.NewEvent(s.idGenerator.GenerateID(), clusterID, kind, message, now)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create event", "cluster_id", clusterID, "error", err)
+
+		return
+	}
+
+	err = s.repository.CreateEvent(ctx, event)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create event", "cluster_id", clusterID, "error", err)
+	}
+}
+
+// recordStatusChange는 status가 바뀌었으면 가용성 계산에 쓸 기록을 남긴다.
+// cluster는 이미 저장되었으므로 기록에 실패해도 본래 작업을 중단하지 않는다.
+func (s *Service) recordStatusChange(
+	ctx context.Context,
+	clusterID string,
+	from domain.ClusterStatus,
+	to domain.ClusterStatus,
+	now time.Time,
+) {
+	if from == to {
+		return
+	}
+
+	change, err := domain.NewStatusChange(clusterID, from, to, now)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create status change", "cluster_id", clusterID, "error", err)
+
+		return
+	}
+
+	err = s.repository.AppendStatusChange(ctx, change)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to append status change", "cluster_id", clusterID, "error", err)
+
+		return
+	}
+
+	s.recorded.Store(clusterID, struct{}{})
+}
+
+// recordStatusBaseline은 status 변경 기록이 없는 cluster에 지금 status를 처음 기록으로 남긴다.
+// 기록이 생기기 전에 등록됐거나 가져온 cluster도 이때부터 가용성을 계산할 수 있다.
+func (s *Service) recordStatusBaseline(ctx context.Context, cluster *domain.Cluster, now time.Time) {
+	if _, ok := s.recorded.Load(cluster.ID()); ok {
+		return
+	}
+
+	changes, err := s.repository.ListStatusChanges(ctx, cluster.ID())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to list status changes", "cluster_id", cluster.ID(), "error", err)
+
+		return
+	}
+
+	if len(changes) > 0 {
+		s.recorded.Store(cluster.ID(), struct{}{})
+
+		return
+	}
+
+	change, err := domain.NewStatusChange(cluster.ID(), cluster.Status(), cluster.Status(), now)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to create status change", "cluster_id", cluster.ID(), "error", err)
+
+		return
+	}
+
+	err = s.repository.AppendStatusChange(ctx, change)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to append status change", "cluster_id", cluster.ID(), "error", err)
+
+		return
+	}
+
+	s.recorded.Store(cluster.ID(), struct{}{})
+}
+
+func (s *Service) ListEvents(ctx context.Context, id string) ([]*Event, error) {
+	_, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	events, err := s.repository.ListEvents(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	return NewEvents(events), nil
+}
+
+func (s *Service) GetCluster(ctx context.Context, id string, now time.Time) (*Cluster, error) {
+	cluster, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	return NewCluster(cluster, now), nil
+}
+
+func (s *Service) GetOverview(ctx context.Context, id string) (*Overview, error) {
+	_, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	overview, err := s.repository.GetOverview(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overview: %w", err)
+	}
+
+	return NewOverview(overview), nil
+}
+
+func (s *Service) ListOSDs(ctx context.Context, id string) ([]*OSD, error) {
+	cluster, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	client, err := s.factory.NewClient(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	defer client.Close()
+
+	osds, err := client.ListOSDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list osds: %w", err)
+	}
+
+	return NewOSDs(osds), nil
+}
+
+func (s *Service) CollectUsage(ctx context.Context, id string, now time.Time) error {
 	cluster, err := s.repository.GetCluster(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to get cluster: %w", err)
@@ -136,20 +566,104 @@ func (s *Service) UpdateMonitor(ctx context.Context, id string) error {
 	}
 	defer client.Close()
 
-	monitors, err := client.ListMonitors(ctx)
+	sample, err := client.GetUsage(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to get usage: %w", err)
+	}
+
+	err = s.repository.AppendUsageSample(ctx, sample)
 	if err != nil {
-		return fmt.Errorf("failed to list monitors: %w", err)
+		return fmt.Errorf("failed to append usage sample: %w", err)
 	}
 
-	cluster, err = cluster.SetHosts(monitors)
+	return nil
+}
+
+func (s *Service) GetUsageTrend(ctx context.Context, id string, since time.Time) (*UsageTrend, error) {
+	_, err := s.repository.GetCluster(ctx, id)
 	if err != nil {
-		return fmt.Errorf("failed to set hosts: %w", err)
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
 	}
 
-	err = s.repository.UpdateCluster(ctx, cluster)
+	samples, err := s.repository.ListUsageSamples(ctx, id, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list usage samples: %w", err)
+	}
+
+	return NewUsageTrend(samples), nil
+}
+
+func (s *Service) UpdateMonitor(ctx context.Context, id string, now time.Time) error {
+	cluster, err := s.repository.GetCluster(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	client, err := s.factory.NewClient(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer client.Close()
+
+	monitorMap, err := client.GetMonitorMap(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get monitor map: %w", err)
+	}
+
+	// 다른 cluster의 monitor로 host를 바꾸지 않도록 fsid를 먼저 확인한다.
+	changed, err := s.applyMonitorMap(ctx, cluster, monitorMap, now)
+	if err != nil {
+		if !errors.Is(err, domain.ErrIdentityMismatch) {
+			return fmt.Errorf("failed to apply monitor map: %w", err)
+		}
+
+		flagged, flagErr := s.flagIdentityMismatch(ctx, cluster, err, now)
+		if flagErr != nil {
+			return fmt.Errorf("failed to flag identity mismatch: %w", flagErr)
+		}
+
+		updateErr := s.repository.UpdateCluster(ctx, flagged)
+		if updateErr != nil {
+			return fmt.Errorf("failed to update cluster: %w", updateErr)
+		}
+
+		return fmt.Errorf("failed to apply monitor map: %w", err)
+	}
+
+	hosts, err := monitorMap.Hosts()
+	if err != nil {
+		return fmt.Errorf("failed to get hosts: %w", err)
+	}
+
+	if changed.HostsPinned() {
+		s.logger.InfoContext(ctx, "hosts are pinned, skip updating hosts", "cluster_id", id)
+	} else {
+		// 새 hosts를 사용할 수 없더라도 monmap은 반영한다.
+		replaced, err := s.replaceHosts(ctx, changed, hosts, now)
+		if err != nil {
+			s.logger.WarnContext(ctx, "failed to replace hosts", "cluster_id", id, "error", err)
+		} else {
+			if replaced != changed {
+				s.recordAudit(ctx, ActorScheduler, id, domain.AuditActionHostsReplaced,
+					"hosts replaced from monmap", changed, replaced, now)
+			}
+
+			changed = replaced
+		}
+	}
+
+	if changed == cluster {
+		return nil
+	}
+
+	err = s.repository.UpdateCluster(ctx, changed)
 	if err != nil {
 		return fmt.Errorf("failed to update cluster: %w", err)
 	}
 
 	return nil
 }
+
+func (s *Service) GetClientPoolStats() *ClientPoolStats {
+	return NewClientPoolStats(s.factory.Stats())
+}
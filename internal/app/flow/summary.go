@@ -0,0 +1,226 @@
+package flow
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+// Summary는 등록된 모든 cluster의 현재 상태를 모은 것이다.
+type Summary struct {
+	Total int
+	// Statuses는 status마다의 cluster 수이다. cluster가 없는 status도 0으로 들어 있다.
+	Statuses map[string]int
+	Stable   int
+	// Reachabilities는 reachability마다의 cluster 수이다.
+	Reachabilities map[string]int
+	// Checks는 가장 많은 cluster에서 발생한 health check부터의 목록이다.
+	Checks []*CheckCount
+	// Groups는 GroupBy label의 value마다 모은 것이다. GroupBy가 없으면 nil이다.
+	Groups        []*SummaryGroup
+	GeneratedTime time.Time
+}
+
+type CheckCount struct {
+	Code string
+	// Severity는 cluster마다 다르면 가장 심각한 것이다.
+	Severity   string
+	Count      int
+	ClusterIDs []string
+}
+
+type SummaryGroup struct {
+	// Value는 label의 value이다. label이 없는 cluster는 HasValue가 false이다.
+	Value    string
+	HasValue bool
+	Total    int
+	Statuses map[string]int
+	Stable   int
+}
+
+type SummaryQuery struct {
+	// GroupBy는 cluster를 나눌 label key이다. 비어 있으면 나누지 않는다.
+	GroupBy string
+	// TopChecks는 반환할 health check의 최대 개수이다. 0이면 기본값을 쓴다.
+	TopChecks int
+	Now       time.Time
+}
+
+// healthCheck는 cluster detail에 담긴 ceph health check 하나이다.
+type healthCheck struct {
+	Severity string `json:"severity"`
+}
+
+// GetSummary는 저장된 cluster 상태만으로 전체 현황을 만든다. ceph에는 접속하지 않는다.
+func (s *Service) GetSummary(ctx context.Context, query *SummaryQuery) (*Summary, error) {
+	const defaultTopChecks = 10
+
+	if query.TopChecks < 0 {
+		return nil, domain.InvalidParameterError("top")
+	}
+
+	topChecks := query.TopChecks
+	if topChecks == 0 {
+		topChecks = defaultTopChecks
+	}
+
+	clusters, err := s.repository.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	ret := &Summary{
+		Total:          len(clusters),
+		Statuses:       newStatusCounts(),
+		Stable:         0,
+		Reachabilities: map[string]int{},
+		Checks:         nil,
+		Groups:         nil,
+		GeneratedTime:  query.Now,
+	}
+
+	checks := map[string]*CheckCount{}
+	groups := map[string]*SummaryGroup{}
+
+	for _, cluster := range clusters {
+		stable := domain.IsClusterStable(cluster, query.Now)
+
+		ret.Statuses[string(cluster.Status())]++
+		ret.Reachabilities[string(cluster.Connection().Reachability())]++
+
+		if stable {
+			ret.Stable++
+		}
+
+		for code, check := range healthChecks(cluster.Detail()) {
+			count, ok := checks[code]
+			if !ok {
+				count = &CheckCount{Code: code, Severity: check.Severity, Count: 0, ClusterIDs: nil}
+				checks[code] = count
+			}
+
+			count.Count++
+			count.ClusterIDs = append(count.ClusterIDs, cluster.ID())
+
+			if check.Severity == string(domain.ClusterStatusHealthError) {
+				count.Severity = check.Severity
+			}
+		}
+
+		if query.GroupBy == "" {
+			continue
+		}
+
+		value, ok := cluster.Labels()[query.GroupBy]
+
+		key := "+" + value
+		if !ok {
+			key = "-"
+		}
+
+		group, exists := groups[key]
+		if !exists {
+			group = &SummaryGroup{Value: value, HasValue: ok, Total: 0, Statuses: newStatusCounts(), Stable: 0}
+			groups[key] = group
+		}
+
+		group.Total++
+		group.Statuses[string(cluster.Status())]++
+
+		if stable {
+			group.Stable++
+		}
+	}
+
+	ret.Checks = topCheckCounts(checks, topChecks)
+
+	if query.GroupBy != "" {
+		ret.Groups = sortedGroups(groups)
+	}
+
+	return ret, nil
+}
+
+func newStatusCounts() map[string]int {
+	ret := map[string]int{}
+	for _, status := range []domain.ClusterStatus{
+		domain.ClusterStatusHealthOK,
+		domain.ClusterStatusHealthWarning,
+		domain.ClusterStatusHealthError,
+		domain.ClusterStatusUnknown,
+		domain.ClusterStatusPending,
+	} {
+		ret[string(status)] = 0
+	}
+
+	return ret
+}
+
+// healthChecks는 cluster detail을 health check code마다 나눈다.
+// detail은 ceph에서 받은 그대로이거나 repository에서 읽은 json이므로 json을 거쳐서 해석한다.
+func healthChecks(detail any) map[string]*healthCheck {
+	if detail == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(detail)
+	if err != nil {
+		return nil
+	}
+
+	var ret map[string]*healthCheck
+
+	err = json.Unmarshal(data, &ret)
+	if err != nil {
+		return nil
+	}
+
+	return ret
+}
+
+// topCheckCounts는 cluster 수가 많은 순, 같으면 code 순으로 limit개를 반환한다.
+func topCheckCounts(checks map[string]*CheckCount, limit int) []*CheckCount {
+	var ret []*CheckCount
+
+	for _, check := range checks {
+		slices.Sort(check.ClusterIDs)
+		ret = append(ret, check)
+	}
+
+	slices.SortFunc(ret, func(a, b *CheckCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Code, b.Code))
+	})
+
+	if len(ret) > limit {
+		ret = ret[:limit]
+	}
+
+	return ret
+}
+
+// sortedGroups는 value 순으로 정렬하고, label이 없는 cluster의 group을 마지막에 둔다.
+func sortedGroups(groups map[string]*SummaryGroup) []*SummaryGroup {
+	ret := []*SummaryGroup{}
+	for _, group := range groups {
+		ret = append(ret, group)
+	}
+
+	slices.SortFunc(ret, func(a, b *SummaryGroup) int {
+		if a.HasValue != b.HasValue {
+			if a.HasValue {
+				return -1
+			}
+
+			return 1
+		}
+
+		return cmp.Compare(a.Value, b.Value)
+	})
+
+	return ret
+}
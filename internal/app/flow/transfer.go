@@ -0,0 +1,510 @@
+package flow
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/keycrypt"
+)
+
+// ExportVersion은 ExportDocument 형식의 버전이다. 형식이 호환되지 않게 바뀌면 올린다.
+const ExportVersion = 1
+
+const (
+	KeyModePlain   = "plain"
+	KeyModeOmit    = "omit"
+	KeyModeEncrypt = "encrypt"
+)
+
+const (
+	ConflictSkip      = "skip"
+	ConflictOverwrite = "overwrite"
+	ConflictFail      = "fail"
+)
+
+const (
+	ImportActionCreated   = "CREATED"
+	ImportActionUpdated   = "UPDATED"
+	ImportActionUnchanged = "UNCHANGED"
+	ImportActionSkipped   = "SKIPPED"
+	ImportActionFailed    = "FAILED"
+)
+
+// ExportDocument는 등록된 cluster의 설정을 다른 cepher로 옮기기 위한 문서이다.
+// 상태나 event처럼 cluster에서 다시 가져올 수 있는 값은 담지 않는다.
+type ExportDocument struct {
+	Version      int
+	ExportedTime time.Time
+	KeyMode      string
+	// Encryption은 KeyMode가 encrypt일 때 key를 복호화하는 데 필요한 값이다.
+	Encryption *KeyEncryption
+	Clusters   []*ExportedCluster
+}
+
+type KeyEncryption struct {
+	Algorithm  string
+	KDF        string
+	Iterations int
+	// Salt는 base64로 인코딩한 값이다.
+	Salt string
+}
+
+type ExportedCluster struct {
+	ID            string
+	Name          string
+	Fsid          string
+	Hosts         []string
+	FallbackHosts []string
+	HostsPinned   bool
+	// Entity는 접속할 때 쓰는 ceph 사용자이다. 비어 있으면 client.admin이다.
+	Entity string
+	// Key는 KeyMode가 omit이면 비어 있고, encrypt이면 암호화한 값이다.
+	Key string
+	// Labels가 nil이면 가져올 때 기존 cluster의 label을 그대로 둔다.
+	Labels map[string]string
+}
+
+type ExportClusters struct {
+	// KeyMode가 비어 있으면 omit이다. key는 plain을 지정했을 때만 그대로 담는다.
+	KeyMode string
+	// Passphrase는 KeyMode가 encrypt일 때 key를 암호화하는 데 쓴다.
+	Passphrase string
+	Now        time.Time
+}
+
+type ImportClusters struct {
+	Document *ExportDocument
+	// DryRun이면 결과만 계산하고 저장하지 않는다.
+	DryRun bool
+	// OnConflict는 fsid나 이름이 같은 cluster가 이미 있을 때의 처리 방법이다. 비어 있으면 skip이다.
+	OnConflict string
+	Passphrase string
+	// Actor는 가져온 사람이다. audit log에 남긴다.
+	Actor string
+	Now   time.Time
+}
+
+type ImportReport struct {
+	DryRun  bool
+	Results []*ImportResult
+}
+
+type ImportResult struct {
+	Name      string
+	Fsid      string
+	ClusterID string
+	Action    string
+	Message   string
+}
+
+// ExportClusters는 등록된 모든 cluster의 설정을 문서로 만든다.
+func (s *Service) ExportClusters(ctx context.Context, exportClusters *ExportClusters) (*ExportDocument, error) {
+	keyMode := exportClusters.KeyMode
+	if keyMode == "" {
+		keyMode = KeyModeOmit
+	}
+
+	document := &ExportDocument{
+		Version:      ExportVersion,
+		ExportedTime: exportClusters.Now,
+		KeyMode:      keyMode,
+		Encryption:   nil,
+		Clusters:     []*ExportedCluster{},
+	}
+
+	var sealer *keycrypt.Cipher
+
+	switch keyMode {
+	case KeyModePlain, KeyModeOmit:
+	case KeyModeEncrypt:
+		params, err := keycrypt.NewParams()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create key derivation parameters: %w", err)
+		}
+
+		sealer, err = keycrypt.New(exportClusters.Passphrase, params)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", domain.InvalidParameterError("passphrase"), err)
+		}
+
+		document.Encryption = &KeyEncryption{
+			Algorithm:  keycrypt.Algorithm,
+			KDF:        keycrypt.KDF,
+			Iterations: params.Iterations,
+			Salt:       base64.StdEncoding.EncodeToString(params.Salt),
+		}
+	default:
+		return nil, domain.InvalidParameterError("key_mode")
+	}
+
+	clusters, err := s.repository.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	for _, cluster := range clusters {
+		var key string
+
+		switch keyMode {
+		case KeyModePlain:
+			key = cluster.Key()
+		case KeyModeEncrypt:
+			key, err = sealer.Seal(cluster.Key())
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt key of %s: %w", cluster.ID(), err)
+			}
+		}
+
+		document.Clusters = append(document.Clusters, &ExportedCluster{
+			ID:            cluster.ID(),
+			Name:          cluster.Name(),
+			Fsid:          cluster.Fsid(),
+			Hosts:         newHosts(cluster.Hosts()),
+			FallbackHosts: newHosts(cluster.FallbackHosts()),
+			HostsPinned:   cluster.HostsPinned(),
+			Entity:        cluster.Entity(),
+			Key:           key,
+			Labels:        cluster.Labels(),
+		})
+	}
+
+	return document, nil
+}
+
+// importPlan은 문서의 cluster 하나를 어떻게 반영할지이다. before가 nil이면 새로 만든다.
+type importPlan struct {
+	result *ImportResult
+	before *domain.Cluster
+	after  *domain.Cluster
+}
+
+// ImportClusters는 문서의 cluster를 등록한다.
+// fsid가 같거나, fsid를 모르는 경우 이름이 같은 cluster를 같은 cluster로 보고 OnConflict에 따라 처리한다.
+// 가져온 cluster는 상태를 모르는 채로 등록되고, 이후 scheduler가 상태를 확인한다.
+func (s *Service) ImportClusters(ctx context.Context, importClusters *ImportClusters) (*ImportReport, error) {
+	document := importClusters.Document
+	if document == nil {
+		return nil, domain.InvalidParameterError("document")
+	}
+
+	if document.Version != ExportVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, document.Version)
+	}
+
+	onConflict := importClusters.OnConflict
+	if onConflict == "" {
+		onConflict = ConflictSkip
+	}
+
+	switch onConflict {
+	case ConflictSkip, ConflictOverwrite, ConflictFail:
+	default:
+		return nil, domain.InvalidParameterError("on_conflict")
+	}
+
+	keys, err := openKeys(document, importClusters.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repository.ListClusters(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	var (
+		plans     []*importPlan
+		conflicts []string
+	)
+
+	for i, exported := range document.Clusters {
+		plan := s.planImport(exported, keys[i], existing, onConflict, importClusters.Now)
+		if plan.after != nil && plan.before == nil {
+			// 같은 문서 안에서 중복된 cluster도 충돌로 처리되도록 만들 cluster를 목록에 더한다.
+			existing = append(existing, plan.after)
+		}
+
+		if onConflict == ConflictFail && plan.before != nil {
+			conflicts = append(conflicts, fmt.Sprintf("%s conflicts with %s", exported.Name, plan.before.ID()))
+		}
+
+		plans = append(plans, plan)
+	}
+
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrImportConflict, strings.Join(conflicts, ", "))
+	}
+
+	report := &ImportReport{
+		DryRun:  importClusters.DryRun,
+		Results: []*ImportResult{},
+	}
+
+	for _, plan := range plans {
+		if !importClusters.DryRun && plan.after != nil {
+			s.applyImport(ctx, plan, importClusters.Actor, importClusters.Now)
+		}
+
+		report.Results = append(report.Results, plan.result)
+	}
+
+	return report, nil
+}
+
+// openKeys는 문서에 담긴 key를 평문으로 바꿔서 cluster 순서대로 반환한다.
+// passphrase가 틀리면 아무것도 가져오지 않도록 하나라도 복호화하지 못하면 오류를 반환한다.
+func openKeys(document *ExportDocument, passphrase string) ([]string, error) {
+	var keys []string
+
+	switch document.KeyMode {
+	case KeyModePlain, KeyModeOmit:
+		for _, exported := range document.Clusters {
+			keys = append(keys, exported.Key)
+		}
+
+		return keys, nil
+	case KeyModeEncrypt:
+	default:
+		return nil, domain.InvalidParameterError("key_mode")
+	}
+
+	encryption := document.Encryption
+	if encryption == nil || encryption.Algorithm != keycrypt.Algorithm || encryption.KDF != keycrypt.KDF {
+		return nil, domain.InvalidParameterError("encryption")
+	}
+
+	salt, err := base64.StdEncoding.DecodeString(encryption.Salt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.InvalidParameterError("encryption"), err)
+	}
+
+	opener, err := keycrypt.New(passphrase, &keycrypt.Params{
+		Iterations: encryption.Iterations,
+		Salt:       salt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domain.InvalidParameterError("passphrase"), err)
+	}
+
+	for _, exported := range document.Clusters {
+		if exported.Key == "" {
+			keys = append(keys, "")
+
+			continue
+		}
+
+		key, err := opener.Open(exported.Key)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key of %s: %w", domain.InvalidParameterError("passphrase"), exported.Name, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func (s *Service) planImport(
+	exported *ExportedCluster,
+	key string,
+	existing []*domain.Cluster,
+	onConflict string,
+	now time.Time,
+) *importPlan {
+	result := &ImportResult{
+		Name:      exported.Name,
+		Fsid:      exported.Fsid,
+		ClusterID: "",
+		Action:    "",
+		Message:   "",
+	}
+	plan := &importPlan{result: result, before: nil, after: nil}
+
+	fail := func(err error) *importPlan {
+		result.Action = ImportActionFailed
+		result.Message = err.Error()
+		plan.after = nil
+
+		return plan
+	}
+
+	hosts, err := domain.NewAddressesFromHosts(exported.Hosts)
+	if err != nil {
+		return fail(fmt.Errorf("failed to create domain addresses: %w", err))
+	}
+
+	fallbackHosts, err := domain.NewAddressesFromHosts(exported.FallbackHosts)
+	if err != nil {
+		return fail(fmt.Errorf("failed to create domain fallback addresses: %w", err))
+	}
+
+	matched := findImportConflict(existing, exported)
+	if matched == nil {
+		id := exported.ID
+		if id == "" || findCluster(existing, id) != nil {
+			id = s.idGenerator.GenerateID()
+		}
+
+		if key == "" {
+			return fail(fmt.Errorf("%w: the document has no key for a new cluster", domain.InvalidParameterError("key")))
+		}
+
+		entity := exported.Entity
+		if entity == "" {
+			entity = domain.DefaultEntity
+		}
+
+		cluster, err := domain.NewCluster(
+			id, exported.Name, exported.Fsid, hosts, fallbackHosts, exported.HostsPinned, entity, key,
+			exported.Labels, domain.ClusterStatusUnknown, now,
+			"", domain.NewUnknownConnection(), nil, nil, nil,
+		)
+		if err != nil {
+			return fail(fmt.Errorf("failed to create cluster: %w", err))
+		}
+
+		result.ClusterID = id
+		result.Action = ImportActionCreated
+		plan.after = cluster
+
+		return plan
+	}
+
+	result.ClusterID = matched.ID()
+	plan.before = matched
+
+	switch {
+	case onConflict != ConflictOverwrite:
+		result.Action = ImportActionSkipped
+		result.Message = "conflicts with " + matched.ID()
+
+		return plan
+	case matched.Fsid() != "" && exported.Fsid != "" && matched.Fsid() != exported.Fsid:
+		return fail(fmt.Errorf("%w: name %s is used by %s with fsid %s",
+			ErrImportConflict, exported.Name, matched.ID(), matched.Fsid()))
+	}
+
+	plan.after, err = overwriteCluster(matched, exported, hosts, fallbackHosts, key)
+	if err != nil {
+		return fail(err)
+	}
+
+	if len(domain.DiffClusters(matched, plan.after)) == 0 {
+		result.Action = ImportActionUnchanged
+		plan.after = nil
+
+		return plan
+	}
+
+	result.Action = ImportActionUpdated
+
+	return plan
+}
+
+// overwriteCluster는 cluster의 설정을 문서의 값으로 바꾼다. 상태는 그대로 둔다.
+// 문서에 entity, key, labels가 없으면 기존 값을 쓴다.
+func overwriteCluster(
+	cluster *domain.Cluster,
+	exported *ExportedCluster,
+	hosts []*domain.Address,
+	fallbackHosts []*domain.Address,
+	key string,
+) (*domain.Cluster, error) {
+	fsid := exported.Fsid
+	if fsid == "" {
+		fsid = cluster.Fsid()
+	}
+
+	entity := exported.Entity
+	if entity == "" {
+		entity = cluster.Entity()
+	}
+
+	if key == "" {
+		key = cluster.Key()
+	}
+
+	labels := exported.Labels
+	if labels == nil {
+		labels = cluster.Labels()
+	}
+
+	ret, err := domain.NewCluster(
+		cluster.ID(), exported.Name, fsid, hosts, fallbackHosts, exported.HostsPinned, entity, key, labels,
+		cluster.Status(), cluster.LastBadTime(),
+		cluster.Detail(), cluster.Connection(), cluster.MonitorMap(), cluster.Probes(), cluster.Versions(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cluster: %w", err)
+	}
+
+	return ret, nil
+}
+
+// findImportConflict는 exported와 같은 cluster로 볼 cluster를 찾는다. fsid가 이름보다 우선한다.
+func findImportConflict(clusters []*domain.Cluster, exported *ExportedCluster) *domain.Cluster {
+	if exported.Fsid != "" {
+		for _, cluster := range clusters {
+			if cluster.Fsid() == exported.Fsid {
+				return cluster
+			}
+		}
+	}
+
+	for _, cluster := range clusters {
+		if cluster.Name() == exported.Name {
+			return cluster
+		}
+	}
+
+	return nil
+}
+
+func findCluster(clusters []*domain.Cluster, id string) *domain.Cluster {
+	for _, cluster := range clusters {
+		if cluster.ID() == id {
+			return cluster
+		}
+	}
+
+	return nil
+}
+
+// applyImport는 plan을 저장하고 audit log에 남긴다. 실패하면 결과에 남기고 다음 cluster를 계속 가져온다.
+func (s *Service) applyImport(ctx context.Context, plan *importPlan, actor string, now time.Time) {
+	var (
+		err    error
+		detail string
+	)
+
+	// 계획한 뒤 다른 등록이나 가져오기가 같은 fsid를 먼저 저장했을 수 있으므로 등록과 같은 확인을 거친다.
+	if plan.before == nil {
+		err = s.saveRegistration(ctx, plan.after, s.repository.CreateCluster)
+		detail = "imported " + plan.after.Name()
+	} else {
+		err = s.saveRegistration(ctx, plan.after, s.repository.UpdateCluster)
+		detail = "overwritten by import"
+	}
+
+	if err != nil {
+		plan.result.Action = ImportActionFailed
+		plan.result.Message = err.Error()
+
+		return
+	}
+
+	s.recordAudit(ctx, actor, plan.after.ID(), domain.AuditActionClusterImported, detail, plan.before, plan.after, now)
+
+	if plan.before == nil {
+		s.recordStatusBaseline(ctx, plan.after, now)
+
+		return
+	}
+
+	s.recordStatusBaseline(ctx, plan.before, now)
+	s.recordStatusChange(ctx, plan.after.ID(), plan.before.Status(), plan.after.Status(), now)
+}
@@ -0,0 +1,119 @@
+package flow_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/app/flow"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/idgenerator/ulid"
+	"github.com/neatflowcv/cepher/internal/pkg/repository"
+	"github.com/neatflowcv/cepher/internal/pkg/repository/file"
+)
+
+// barrierRepository는 처음 n번의 ListClusters가 읽은 목록을 n번째 호출이 올 때까지 돌려주지 않는다.
+// 모든 가져오기가 아무것도 저장되지 않은 목록으로 계획하게 만든다.
+type barrierRepository struct {
+	repository.Repository
+
+	mu        sync.Mutex
+	remaining int
+	release   chan struct{}
+}
+
+func (r *barrierRepository) ListClusters(ctx context.Context) ([]*domain.Cluster, error) {
+	clusters, err := r.Repository.ListClusters(ctx)
+
+	r.mu.Lock()
+	if r.remaining > 0 {
+		r.remaining--
+		if r.remaining == 0 {
+			close(r.release)
+		}
+	}
+	r.mu.Unlock()
+
+	<-r.release
+
+	return clusters, err //nolint:wrapcheck
+}
+
+func TestService_ImportClusters_ConcurrentSameFsid(t *testing.T) {
+	t.Parallel()
+
+	const imports = 8
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	repo := &barrierRepository{ //nolint:exhaustruct
+		Repository: file.NewRepository(t.TempDir(), logger),
+		remaining:  imports,
+		release:    make(chan struct{}),
+	}
+	service := flow.NewService(ulid.NewGenerator(), nil, repo, &fakeProber{reachable: true}, logger)
+
+	now := time.Now()
+
+	var wg sync.WaitGroup
+
+	actions := make([]string, imports)
+
+	for i := range imports {
+		wg.Go(func() {
+			// 이름이 달라 계획 단계에서는 서로 충돌하지 않고, 저장할 때 fsid로만 걸러진다.
+			report, err := service.ImportClusters(t.Context(), &flow.ImportClusters{
+				Document: &flow.ExportDocument{
+					Version:      flow.ExportVersion,
+					ExportedTime: now,
+					KeyMode:      flow.KeyModePlain,
+					Encryption:   nil,
+					Clusters: []*flow.ExportedCluster{{
+						ID:            "",
+						Name:          "import-" + string(rune('a'+i)),
+						Fsid:          "6f1c9a2e-4b7d-11ef-9c3a-525400a1b2c3",
+						Hosts:         []string{"v1:192.168.10.11:6789"},
+						FallbackHosts: nil,
+						HostsPinned:   false,
+						Entity:        "",
+						Key:           "AQ==",
+						Labels:        nil,
+					}},
+				},
+				DryRun:     false,
+				OnConflict: flow.ConflictSkip,
+				Passphrase: "",
+				Actor:      "admin",
+				Now:        now,
+			})
+			if err != nil {
+				t.Errorf("ImportClusters() error = %v", err)
+
+				return
+			}
+
+			actions[i] = report.Results[0].Action
+		})
+	}
+
+	wg.Wait()
+
+	created := 0
+
+	for _, action := range actions {
+		if action == flow.ImportActionCreated {
+			created++
+		}
+	}
+
+	clusters, err := repo.ListClusters(t.Context())
+	if err != nil {
+		t.Fatalf("ListClusters() error = %v", err)
+	}
+
+	if created != 1 || len(clusters) != 1 {
+		t.Errorf("created = %d, clusters = %d, want 1 and 1 (actions %v)", created, len(clusters), actions)
+	}
+}
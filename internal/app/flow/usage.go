@@ -0,0 +1,98 @@
+package flow
+
+import (
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type UsagePoint struct {
+	Time      time.Time
+	UsedBytes int64
+	UsedRatio float64
+}
+
+type Forecast struct {
+	Threshold float64
+	State     string
+	Time      time.Time
+}
+
+type UsageSeries struct {
+	Name     string
+	History  []*UsagePoint
+	NearFull *Forecast
+	Full     *Forecast
+}
+
+type UsageTrend struct {
+	NearFullRatio float64
+	FullRatio     float64
+	Cluster       *UsageSeries
+	Pools         []*UsageSeries
+}
+
+func NewUsageTrend(samples []*domain.UsageSample) *UsageTrend {
+	cluster := &UsageSeries{
+		Name:     "",
+		History:  nil,
+		NearFull: newForecast(domain.ForecastCluster(samples, domain.NearFullRatio)),
+		Full:     newForecast(domain.ForecastCluster(samples, domain.FullRatio)),
+	}
+
+	for _, sample := range samples {
+		cluster.History = append(cluster.History, &UsagePoint{
+			Time:      sample.CollectedTime(),
+			UsedBytes: sample.UsedBytes(),
+			UsedRatio: sample.UsedRatio(),
+		})
+	}
+
+	var pools []*UsageSeries
+
+	// 삭제된 pool은 제외하기 위해 가장 최근 sample의 pool만 보여준다.
+	if len(samples) > 0 {
+		for _, pool := range samples[len(samples)-1].Pools() {
+			pools = append(pools, newPoolSeries(samples, pool.Name()))
+		}
+	}
+
+	return &UsageTrend{
+		NearFullRatio: domain.NearFullRatio,
+		FullRatio:     domain.FullRatio,
+		Cluster:       cluster,
+		Pools:         pools,
+	}
+}
+
+func newPoolSeries(samples []*domain.UsageSample, name string) *UsageSeries {
+	ret := &UsageSeries{
+		Name:     name,
+		History:  nil,
+		NearFull: newForecast(domain.ForecastPool(samples, name, domain.NearFullRatio)),
+		Full:     newForecast(domain.ForecastPool(samples, name, domain.FullRatio)),
+	}
+
+	for _, sample := range samples {
+		pool := sample.Pool(name)
+		if pool == nil {
+			continue
+		}
+
+		ret.History = append(ret.History, &UsagePoint{
+			Time:      sample.CollectedTime(),
+			UsedBytes: pool.UsedBytes(),
+			UsedRatio: pool.UsedRatio(),
+		})
+	}
+
+	return ret
+}
+
+func newForecast(forecast *domain.Forecast) *Forecast {
+	return &Forecast{
+		Threshold: forecast.Threshold(),
+		State:     string(forecast.State()),
+		Time:      forecast.Time(),
+	}
+}
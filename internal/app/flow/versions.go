@@ -0,0 +1,38 @@
+package flow
+
+import "github.com/neatflowcv/cepher/internal/pkg/domain"
+
+type Versions struct {
+	Release string
+	Mixed   bool
+	Daemons []*DaemonVersion
+}
+
+type DaemonVersion struct {
+	Daemon  string
+	Version string
+	Release string
+	Count   int
+}
+
+func NewVersions(versions *domain.Versions) *Versions {
+	if versions == nil {
+		return nil
+	}
+
+	var daemons []*DaemonVersion
+	for _, daemon := range versions.Daemons() {
+		daemons = append(daemons, &DaemonVersion{
+			Daemon:  daemon.Daemon(),
+			Version: daemon.Version(),
+			Release: string(daemon.Release()),
+			Count:   daemon.Count(),
+		})
+	}
+
+	return &Versions{
+		Release: string(versions.Release()),
+		Mixed:   versions.IsMixed(),
+		Daemons: daemons,
+	}
+}
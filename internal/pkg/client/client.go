@@ -2,17 +2,32 @@ package client
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/neatflowcv/cepher/internal/pkg/domain"
 )
 
+// Factory는 cluster에 접근하는 Client를 만든다.
+// cluster마다 설정을 만들어 둔 session을 재사용하므로, 다 쓴 뒤에는 Close로 정리해야 한다.
 type Factory interface {
 	NewClient(ctx context.Context, cluster *domain.Cluster) (Client, error)
+	// NewCandidateClient는 session을 재사용하지 않는 Client를 만든다. Close하면 session도 지운다.
+	// 저장하기 전의 설정을 시험할 때 쓰며, 같은 cluster가 쓰고 있는 session을 버리지 않는다.
+	NewCandidateClient(ctx context.Context, cluster *domain.Cluster) (Client, error)
+	Stats() PoolStats
+	Close()
 }
 
 type Client interface {
 	Close()
 
 	HealthCheck(ctx context.Context) (domain.ClusterStatus, any, error)
-	ListMonitors(ctx context.Context) ([]*domain.Address, error)
+	GetMonitorMap(ctx context.Context) (*domain.MonitorMap, error)
+	GetOverview(ctx context.Context, now time.Time) (*domain.Overview, error)
+	ListOSDs(ctx context.Context) ([]*domain.OSD, error)
+	GetUsage(ctx context.Context, now time.Time) (*domain.UsageSample, error)
+	// GetVersions는 monitorMap의 min_mon_release를 cluster의 release로 쓴다. 없으면 가장 오래된 monitor의 release이다.
+	GetVersions(ctx context.Context, monitorMap *domain.MonitorMap) (*domain.Versions, error)
+	RunCommand(ctx context.Context, command *domain.Command) (json.RawMessage, error)
 }
@@ -2,73 +2,335 @@ package core
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
-	"os"
+	"log/slog"
+	"maps"
+	"net"
+	"slices"
+	"time"
 
 	"github.com/neatflowcv/cepher/internal/pkg/client"
 	"github.com/neatflowcv/cepher/internal/pkg/domain"
 	"github.com/neatflowcv/cepher/pkg/cephcli"
+	"github.com/neatflowcv/cepher/pkg/cephsetup"
 )
 
 var _ client.Client = (*Client)(nil)
 
 type Client struct {
-	client *cephcli.Client
-	path   string
+	client    *cephcli.Client
+	pool      *pool
+	session   *session
+	clusterID string
+	hosts     []string
+	logger    *slog.Logger
 }
 
-func newClient(path, version string) *Client {
+// newClient는 session의 ceph.conf와 keyring을 쓰되, addresses의 순서대로 monitor에 접속하는 client를 만든다.
+func newClient(
+	runner cephcli.Runner,
+	pool *pool,
+	session *session,
+	addresses []*domain.Address,
+	version string,
+	clusterID string,
+	logger *slog.Logger,
+) *Client {
+	var hosts []string
+	for _, address := range addresses {
+		hosts = append(hosts, address.HostPort())
+	}
+
+	monHost := cephsetup.FormatMonHost(groupAddresses(addresses))
+
 	return &Client{
-		client: cephcli.NewClient(path, version),
-		path:   path,
+		client:    cephcli.NewClient(runner, session.path, session.entity, monHost, version),
+		pool:      pool,
+		session:   session,
+		clusterID: clusterID,
+		hosts:     hosts,
+		logger:    logger,
 	}
 }
 
+// Close는 session을 pool에 돌려준다.
 func (c *Client) Close() {
-	err := os.RemoveAll(c.path)
-	if err != nil {
-		log.Printf("failed to remove temporary directory: %v", err)
-	}
+	c.pool.release(c.session, time.Now())
 }
 
 func (c *Client) HealthCheck(ctx context.Context) (domain.ClusterStatus, any, error) {
 	health, err := c.client.HealthDetail(ctx)
 	if err != nil {
-		return domain.ClusterStatusUnknown, "", fmt.Errorf("failed to get health: %w", err)
+		return domain.ClusterStatusUnknown, "", fmt.Errorf("failed to get health: %w", c.wrapError(ctx, err))
 	}
 
 	return domain.ClusterStatus(health.Status), health.Checks, nil
 }
 
-func (c *Client) ListMonitors(ctx context.Context) ([]*domain.Address, error) {
+func (c *Client) GetMonitorMap(ctx context.Context) (*domain.MonitorMap, error) {
 	dump, err := c.client.MonDump(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list monitors: %w", err)
+		return nil, fmt.Errorf("failed to get mon dump: %w", c.wrapError(ctx, err))
 	}
 
-	var ret []*domain.Address
+	var monitors []*domain.Monitor
 
 	for _, mon := range dump.Mons {
-		var (
-			maxAddr string
-			maxType string
-		)
+		var addrs []*domain.MonitorAddress
 
 		for _, addr := range mon.PublicAddrs.Addrvec {
-			if addr.Type > maxType {
-				maxType = addr.Type
-				maxAddr = addr.Addr
+			address, err := domain.NewMonitorAddress(addr.Type, addr.Addr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create domain monitor address: %w", err)
 			}
+
+			addrs = append(addrs, address)
 		}
 
-		address, err := domain.NewAddressFromHost(maxAddr)
+		monitor, err := domain.NewMonitor(mon.Name, mon.Rank, addrs, slices.Contains(dump.Quorum, mon.Rank))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain monitor: %w", err)
+		}
+
+		monitors = append(monitors, monitor)
+	}
+
+	monitorMap, err := domain.NewMonitorMap(dump.Fsid, dump.Epoch, domain.Release(dump.MinMonReleaseName), monitors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain monitor map: %w", err)
+	}
+
+	return monitorMap, nil
+}
+
+func (c *Client) GetOverview(ctx context.Context, now time.Time) (*domain.Overview, error) {
+	status, err := c.client.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status: %w", c.wrapError(ctx, err))
+	}
+
+	df, err := c.client.DF(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get df: %w", c.wrapError(ctx, err))
+	}
+
+	capacity, err := domain.NewCapacity(
+		df.Stats.TotalBytes, df.Stats.TotalUsedRawBytes, df.Stats.TotalAvailBytes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain capacity: %w", err)
+	}
+
+	osds, err := domain.NewOSDCount(status.Osdmap.NumOsds, status.Osdmap.NumUpOsds, status.Osdmap.NumInOsds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain osd count: %w", err)
+	}
+
+	states := make(map[string]int)
+	for _, state := range status.Pgmap.PgsByState {
+		states[state.StateName] += state.Count
+	}
+
+	pgs, err := domain.NewPGCount(status.Pgmap.NumPgs, states)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain pg count: %w", err)
+	}
+
+	clientIO := domain.NewClientIO(
+		status.Pgmap.ReadBytesSec, status.Pgmap.WriteBytesSec,
+		status.Pgmap.ReadOpPerSec, status.Pgmap.WriteOpPerSec,
+	)
+
+	overview, err := domain.NewOverview(
+		c.clusterID, now, capacity, osds, pgs, len(df.Pools), clientIO, status.QuorumNames,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain overview: %w", err)
+	}
+
+	return overview, nil
+}
+
+func (c *Client) ListOSDs(ctx context.Context) ([]*domain.OSD, error) {
+	tree, err := c.client.OsdTree(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get osd tree: %w", c.wrapError(ctx, err))
+	}
+
+	df, err := c.client.OsdDF(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get osd df: %w", c.wrapError(ctx, err))
+	}
+
+	hosts := make(map[int]string)
+
+	for _, node := range tree.Nodes {
+		if node.Type != "host" {
+			continue
+		}
+
+		for _, child := range node.Children {
+			hosts[child] = node.Name
+		}
+	}
+
+	usages := make(map[int]cephcli.OsdDFNode)
+	for _, node := range df.Nodes {
+		usages[node.ID] = node
+	}
+
+	var ret []*domain.OSD
+
+	for _, node := range slices.Concat(tree.Nodes, tree.Stray) {
+		if node.Type != "osd" {
+			continue
+		}
+
+		usage := usages[node.ID]
+
+		osd, err := domain.NewOSD(
+			node.ID, node.Name, hosts[node.ID], node.DeviceClass,
+			node.Status == "up", node.Reweight > 0,
+			node.CrushWeight, node.Reweight, usage.Utilization, usage.Pgs,
+		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create domain address: %w", err)
+			return nil, fmt.Errorf("failed to create domain osd: %w", err)
 		}
 
-		ret = append(ret, address)
+		ret = append(ret, osd)
 	}
 
 	return ret, nil
 }
+
+func (c *Client) GetUsage(ctx context.Context, now time.Time) (*domain.UsageSample, error) {
+	df, err := c.client.DFDetail(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get df detail: %w", c.wrapError(ctx, err))
+	}
+
+	var pools []*domain.PoolUsage
+
+	for _, pool := range df.Pools {
+		usage, err := domain.NewPoolUsage(
+			pool.Name, pool.Stats.Stored, pool.Stats.BytesUsed, pool.Stats.MaxAvail, pool.Stats.PercentUsed,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain pool usage: %w", err)
+		}
+
+		pools = append(pools, usage)
+	}
+
+	sample, err := domain.NewUsageSample(
+		c.clusterID, now, df.Stats.TotalBytes, df.Stats.TotalUsedRawBytes, df.Stats.TotalUsedRawRatio, pools,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain usage sample: %w", err)
+	}
+
+	return sample, nil
+}
+
+func (c *Client) GetVersions(ctx context.Context, monitorMap *domain.MonitorMap) (*domain.Versions, error) {
+	versions, err := c.client.Versions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get versions: %w", c.wrapError(ctx, err))
+	}
+
+	var daemons []*domain.DaemonVersion
+
+	// overall은 다른 항목의 합계이므로 daemon으로 취급하지 않는다.
+	for _, daemon := range slices.Sorted(maps.Keys(versions)) {
+		if daemon == "overall" {
+			continue
+		}
+
+		for _, description := range slices.Sorted(maps.Keys(versions[daemon])) {
+			version, err := domain.ParseDaemonVersion(daemon, description, versions[daemon][description])
+			if err != nil {
+				return nil, fmt.Errorf("failed to create domain daemon version: %w", err)
+			}
+
+			daemons = append(daemons, version)
+		}
+	}
+
+	// 모든 monitor가 보장하는 release는 monmap의 min_mon_release_name이다.
+	var release domain.Release
+	if monitorMap != nil {
+		release = monitorMap.MinMonRelease()
+	}
+
+	ret, err := domain.NewVersions(release, daemons)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain versions: %w", err)
+	}
+
+	return ret, nil
+}
+
+func (c *Client) RunCommand(ctx context.Context, command *domain.Command) (json.RawMessage, error) {
+	output, err := c.client.Command(ctx, command.Args()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run %s: %w", command, c.wrapError(ctx, err))
+	}
+
+	return output, nil
+}
+
+// wrapError는 cephcli 오류를 client 패키지의 오류로 분류한다.
+func (c *Client) wrapError(ctx context.Context, err error) error {
+	switch {
+	case errors.Is(err, cephcli.ErrPermissionDenied):
+		return fmt.Errorf("%w: %w", client.ErrAuthFailure, err)
+	case errors.Is(err, cephcli.ErrRuntime):
+		return fmt.Errorf("%w: %w", client.ErrRuntime, err)
+	case errors.Is(err, cephcli.ErrTimedOut):
+		// monitor 포트가 열려 있는데도 연결하지 못했다면 quorum이 깨진 것으로 본다.
+		if c.anyHostListening(ctx) {
+			return fmt.Errorf("%w: %w", client.ErrQuorumLost, err)
+		}
+
+		return fmt.Errorf("%w: %w", client.ErrTimeout, err)
+	default:
+		return err
+	}
+}
+
+// anyHostListening은 monitor 중 하나라도 TCP 연결을 받는지 확인한다.
+// 모든 host에 동시에 접속해 보고 처음 연결되면 바로 돌아온다. ctx가 끝나면 더 기다리지 않는다.
+func (c *Client) anyHostListening(ctx context.Context) bool {
+	const timeout = 3 * time.Second
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialer := &net.Dialer{} //nolint:exhaustruct
+	listening := make(chan bool, len(c.hosts))
+
+	for _, host := range c.hosts {
+		go func() {
+			conn, err := dialer.DialContext(ctx, "tcp", host)
+			if err != nil {
+				listening <- false
+
+				return
+			}
+
+			_ = conn.Close()
+
+			listening <- true
+		}()
+	}
+
+	for range c.hosts {
+		if <-listening {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,318 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/client"
+	"github.com/neatflowcv/cepher/internal/pkg/client/core"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/pkg/cephcli"
+)
+
+// newTestClient는 runner가 기록된 출력을 돌려주는 client를 만든다.
+func newTestClient(t *testing.T, runner cephcli.Runner) client.Client {
+	t.Helper()
+
+	return newTestClientWithHosts(t, runner, []string{"192.168.10.11:6789"})
+}
+
+// newTestClientWithHosts는 monitor가 hosts인 cluster의 client를 만든다.
+func newTestClientWithHosts(t *testing.T, runner cephcli.Runner, monitors []string) client.Client {
+	t.Helper()
+
+	hosts, err := domain.NewAddressesFromHosts(monitors)
+	if err != nil {
+		t.Fatalf("failed to create hosts: %v", err)
+	}
+
+	cluster, err := domain.NewCluster(
+		"cluster-1", "test", "", hosts, nil, false, "client.admin", "AQ==",
+		nil, domain.ClusterStatusUnknown, time.Now(), "", domain.NewUnknownConnection(), nil, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create cluster: %v", err)
+	}
+
+	factory := core.NewFactory(runner, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	ret, err := factory.NewCandidateClient(t.Context(), cluster)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	t.Cleanup(ret.Close)
+
+	return ret
+}
+
+// readFixture는 실제 ceph에서 `-f json`으로 받아 둔 출력을 읽는다.
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	return data
+}
+
+func TestClient_HealthCheck(t *testing.T) {
+	t.Parallel()
+
+	runner := cephcli.NewFakeRunner()
+	runner.SetOutput(readFixture(t, "health_detail.json"), "health", "detail")
+
+	status, detail, err := newTestClient(t, runner).HealthCheck(t.Context())
+	if err != nil {
+		t.Fatalf("HealthCheck() error = %v", err)
+	}
+
+	if status != domain.ClusterStatusHealthWarning {
+		t.Errorf("status = %q, want %q", status, domain.ClusterStatusHealthWarning)
+	}
+
+	checks, ok := detail.(map[string]cephcli.Check)
+	if !ok {
+		t.Fatalf("detail type = %T, want map[string]cephcli.Check", detail)
+	}
+
+	osdDown, ok := checks["OSD_DOWN"]
+	if !ok {
+		t.Fatalf("checks = %v, want OSD_DOWN", checks)
+	}
+
+	if osdDown.Severity != "HEALTH_WARN" || osdDown.Summary.Message != "1 osds down" || osdDown.Summary.Count != 1 {
+		t.Errorf("OSD_DOWN = %+v", osdDown)
+	}
+
+	if _, ok := checks["MON_CLOCK_SKEW"]; !ok {
+		t.Errorf("checks = %v, want MON_CLOCK_SKEW", checks)
+	}
+}
+
+func TestClient_HealthCheck_PermissionDenied(t *testing.T) {
+	t.Parallel()
+
+	runner := cephcli.NewFakeRunner()
+	runner.SetError(cephcli.ErrPermissionDenied, "health", "detail")
+
+	status, _, err := newTestClient(t, runner).HealthCheck(t.Context())
+	if !errors.Is(err, client.ErrAuthFailure) {
+		t.Errorf("HealthCheck() error = %v, want %v", err, client.ErrAuthFailure)
+	}
+
+	if status != domain.ClusterStatusUnknown {
+		t.Errorf("status = %q, want %q", status, domain.ClusterStatusUnknown)
+	}
+}
+
+func TestClient_GetMonitorMap(t *testing.T) {
+	t.Parallel()
+
+	runner := cephcli.NewFakeRunner()
+	runner.SetOutput(readFixture(t, "mon_dump.json"), "mon", "dump")
+
+	monitorMap, err := newTestClient(t, runner).GetMonitorMap(t.Context())
+	if err != nil {
+		t.Fatalf("GetMonitorMap() error = %v", err)
+	}
+
+	if monitorMap.Fsid() != "6f1c9a2e-4b7d-11ef-9c3a-525400a1b2c3" {
+		t.Errorf("fsid = %q", monitorMap.Fsid())
+	}
+
+	if monitorMap.Epoch() != 3 {
+		t.Errorf("epoch = %d, want 3", monitorMap.Epoch())
+	}
+
+	if monitorMap.MinMonRelease() != domain.ReleaseReef {
+		t.Errorf("min mon release = %q, want %q", monitorMap.MinMonRelease(), domain.ReleaseReef)
+	}
+
+	if len(monitorMap.Monitors()) != 3 {
+		t.Fatalf("monitors = %d, want 3", len(monitorMap.Monitors()))
+	}
+
+	tests := []struct {
+		name     string
+		rank     int
+		inQuorum bool
+		addrs    []string
+	}{
+		{name: "ceph-node-a", rank: 0, inQuorum: true, addrs: []string{"v2 192.168.10.11:3300", "v1 192.168.10.11:6789"}},
+		{name: "ceph-node-b", rank: 1, inQuorum: true, addrs: []string{"v2 192.168.10.12:3300", "v1 192.168.10.12:6789"}},
+		{name: "ceph-node-c", rank: 2, inQuorum: false, addrs: []string{"v2 192.168.10.13:3300", "v1 192.168.10.13:6789"}},
+	}
+
+	for _, tt := range tests {
+		monitor := monitorMap.Monitor(tt.name)
+		if monitor == nil {
+			t.Errorf("monitor %s not found", tt.name)
+
+			continue
+		}
+
+		if monitor.Rank() != tt.rank || monitor.InQuorum() != tt.inQuorum {
+			t.Errorf("monitor %s rank = %d, in quorum = %t", tt.name, monitor.Rank(), monitor.InQuorum())
+		}
+
+		var addrs []string
+		for _, addr := range monitor.Addrs() {
+			addrs = append(addrs, addr.Protocol()+" "+addr.Addr())
+		}
+
+		if !slices.Equal(addrs, tt.addrs) {
+			t.Errorf("monitor %s addrs = %v, want %v", tt.name, addrs, tt.addrs)
+		}
+	}
+}
+
+func TestClient_GetVersions(t *testing.T) {
+	t.Parallel()
+
+	runner := cephcli.NewFakeRunner()
+	runner.SetOutput(readFixture(t, "mon_dump.json"), "mon", "dump")
+	runner.SetOutput(readFixture(t, "versions.json"), "versions")
+
+	testClient := newTestClient(t, runner)
+
+	monitorMap, err := testClient.GetMonitorMap(t.Context())
+	if err != nil {
+		t.Fatalf("GetMonitorMap() error = %v", err)
+	}
+
+	versions, err := testClient.GetVersions(t.Context(), monitorMap)
+	if err != nil {
+		t.Fatalf("GetVersions() error = %v", err)
+	}
+
+	if versions.Release() != domain.ReleaseReef {
+		t.Errorf("release = %q, want %q", versions.Release(), domain.ReleaseReef)
+	}
+
+	if !versions.IsMixed() {
+		t.Error("IsMixed() = false, want true")
+	}
+
+	const wantDaemons = 4 // mgr, mon, osd 두 버전
+
+	if len(versions.Daemons()) != wantDaemons {
+		t.Errorf("daemons = %d, want %d", len(versions.Daemons()), wantDaemons)
+	}
+
+	// release는 이미 읽은 monmap에서 가져오므로 mon dump를 다시 실행하지 않는다.
+	var commands []string
+	for _, command := range runner.Commands() {
+		commands = append(commands, strings.Join(command.Args, " "))
+	}
+
+	if !slices.Equal(commands, []string{"mon dump", "versions"}) {
+		t.Errorf("commands = %v, want [mon dump versions]", commands)
+	}
+}
+
+func TestClient_GetVersions_WithoutMonitorMap(t *testing.T) {
+	t.Parallel()
+
+	runner := cephcli.NewFakeRunner()
+	runner.SetOutput(readFixture(t, "versions.json"), "versions")
+
+	versions, err := newTestClient(t, runner).GetVersions(t.Context(), nil)
+	if err != nil {
+		t.Fatalf("GetVersions() error = %v", err)
+	}
+
+	// monmap이 없으면 가장 오래된 monitor의 release이다.
+	if versions.Release() != domain.ReleaseReef {
+		t.Errorf("release = %q, want %q", versions.Release(), domain.ReleaseReef)
+	}
+}
+
+// listeningHost는 연결을 받는 주소이다. test가 끝나면 닫는다.
+func listeningHost(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	return listener.Addr().String()
+}
+
+// closedHost는 연결을 거절하는 주소이다.
+func closedHost(t *testing.T) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	host := listener.Addr().String()
+	_ = listener.Close()
+
+	return host
+}
+
+func TestClient_HealthCheck_TimedOut(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		hosts    func(t *testing.T) []string
+		canceled bool
+		want     error
+	}{
+		{
+			name:  "no monitor listening",
+			hosts: func(t *testing.T) []string { return []string{closedHost(t), closedHost(t)} },
+			want:  client.ErrTimeout,
+		},
+		{
+			name:  "one of the monitors listening",
+			hosts: func(t *testing.T) []string { return []string{closedHost(t), listeningHost(t)} },
+			want:  client.ErrQuorumLost,
+		},
+		{
+			name:     "caller gave up",
+			hosts:    func(t *testing.T) []string { return []string{listeningHost(t)} },
+			canceled: true,
+			want:     client.ErrTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			runner := cephcli.NewFakeRunner()
+			runner.SetError(cephcli.ErrTimedOut, "health", "detail")
+
+			ctx, cancel := context.WithCancel(t.Context())
+			if tt.canceled {
+				cancel()
+			} else {
+				defer cancel()
+			}
+
+			_, _, err := newTestClientWithHosts(t, runner, tt.hosts(t)).HealthCheck(ctx)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("HealthCheck() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
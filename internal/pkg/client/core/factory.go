@@ -1,45 +1,262 @@
 package core
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
-	"math/rand/v2"
+	"log/slog"
+	"net"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/neatflowcv/cepher/internal/pkg/client"
 	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/tracing"
+	"github.com/neatflowcv/cepher/pkg/cephcli"
 	"github.com/neatflowcv/cepher/pkg/cephsetup"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var _ client.Factory = (*Factory)(nil)
 
+var tracer = otel.Tracer("github.com/neatflowcv/cepher/internal/pkg/client/core") //nolint:gochecknoglobals
+
+// Factory는 cluster마다 만든 ceph.conf와 keyring을 session으로 보관해 여러 poll에서 재사용한다.
+// cluster의 hosts나 key가 바뀌면 session을 새로 만든다.
+// 접속할 monitor의 순서는 session에 담지 않고 client마다 넘긴다.
 type Factory struct {
+	runner cephcli.Runner
+	pool   *pool
+	logger *slog.Logger
+}
+
+func NewFactory(runner cephcli.Runner, logger *slog.Logger) *Factory {
+	const idleTimeout = 24 * time.Hour
+
+	return &Factory{
+		runner: runner,
+		pool:   newPool(idleTimeout, logger),
+		logger: logger,
+	}
 }
 
-func NewFactory() *Factory {
-	return &Factory{}
+func (f *Factory) NewClient(ctx context.Context, cluster *domain.Cluster) (_ client.Client, err error) {
+	ctx, span := tracer.Start(ctx, "Factory.NewClient", trace.WithAttributes(
+		attribute.String("cepher.cluster_id", cluster.ID()),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	addresses, err := resolveAddresses(ctx, cluster.PrioritizedHosts())
+	if err != nil {
+		return nil, err
+	}
+
+	created := false
+
+	session, err := f.pool.acquire(
+		cluster.ID(),
+		fingerprint(addresses, cluster.Entity(), cluster.Key()),
+		time.Now(),
+		func() (*session, error) {
+			created = true
+
+			return createSession(ctx, sortAddresses(addresses), cluster.Entity(), cluster.Key())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Bool("cepher.session_created", created))
+
+	return newClient(
+		f.runner, f.pool, session, addresses, imageVersion(cluster.Release()), cluster.ID(), f.logger,
+	), nil
 }
 
-func (f *Factory) NewClient(ctx context.Context, cluster *domain.Cluster) (client.Client, error) {
+func (f *Factory) NewCandidateClient(ctx context.Context, cluster *domain.Cluster) (_ client.Client, err error) {
+	ctx, span := tracer.Start(ctx, "Factory.NewCandidateClient", trace.WithAttributes(
+		attribute.String("cepher.cluster_id", cluster.ID()),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	addresses, err := resolveAddresses(ctx, cluster.PrioritizedHosts())
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := createSession(ctx, addresses, cluster.Entity(), cluster.Key())
+	if err != nil {
+		return nil, err
+	}
+
+	// pool에 넣지 않은 session이므로 Close로 반납하면 바로 지워진다.
+	session.refs = 1
+	session.invalid = true
+
+	return newClient(
+		f.runner, f.pool, session, addresses, imageVersion(cluster.Release()), cluster.ID(), f.logger,
+	), nil
+}
+
+// imageVersion은 cluster의 release와 같은 release의 ceph CLI 버전을 고른다.
+// release를 아직 모르거나 알지 못하는 release면 가장 최신 버전을 쓴다.
+func imageVersion(release domain.Release) string {
+	const latest = "20.1.1"
+
+	switch release {
+	case domain.ReleaseQuincy:
+		return "17.2.8"
+	case domain.ReleaseReef:
+		return "18.2.7"
+	case domain.ReleaseSquid:
+		return "19.2.3"
+	case domain.ReleaseTentacle:
+		return latest
+	default:
+		return latest
+	}
+}
+
+func (f *Factory) Stats() client.PoolStats {
+	return f.pool.snapshot()
+}
+
+// Close는 보관 중인 session을 모두 정리한다. 사용 중인 session은 반납될 때 정리된다.
+func (f *Factory) Close() {
+	f.pool.close()
+}
+
+func createSession(ctx context.Context, addresses []*domain.Address, entity string, key string) (*session, error) {
 	tempDir, err := os.MkdirTemp("", "cepher")
 	if err != nil {
 		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
 	}
 
-	hosts := cluster.Hosts()
-	rand.Shuffle(len(hosts), func(i, j int) {
-		hosts[i], hosts[j] = hosts[j], hosts[i]
-	})
+	_, span := tracer.Start(ctx, "cephsetup.Setup")
+	err = cephsetup.Setup(tempDir, groupAddresses(addresses), entity, key)
 
-	var hostStrings []string
-	for _, host := range hosts {
-		hostStrings = append(hostStrings, host.String())
-	}
+	tracing.End(span, err)
 
-	err = cephsetup.Setup(tempDir, hostStrings, cluster.Key())
 	if err != nil {
+		_ = os.RemoveAll(tempDir)
+
 		return nil, fmt.Errorf("failed to setup ceph: %w", err)
 	}
 
-	return newClient(tempDir, "20.1.1"), nil
+	return &session{ //nolint:exhaustruct
+		path:   tempDir,
+		entity: entity,
+	}, nil
+}
+
+// fingerprint는 session을 다시 만들어야 하는지 판단하는 값이다.
+// monitor 순서는 probe latency에 따라 poll마다 바뀌므로 정렬한 hosts로 계산한다.
+func fingerprint(addresses []*domain.Address, entity string, key string) string {
+	var hosts []string
+	for _, address := range sortAddresses(addresses) {
+		hosts = append(hosts, address.String())
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(hosts, ",") + "\n" + entity + "\n" + key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func sortAddresses(addresses []*domain.Address) []*domain.Address {
+	return slices.SortedFunc(slices.Values(addresses), func(a, b *domain.Address) int {
+		return strings.Compare(a.String(), b.String())
+	})
+}
+
+// resolveAddresses는 DNS 이름으로 된 주소를 IP 주소로 바꾼다.
+// 이름이 여러 IP로 해석되면 모든 IP를 사용한다.
+func resolveAddresses(ctx context.Context, addresses []*domain.Address) ([]*domain.Address, error) {
+	var ret []*domain.Address
+
+	for _, address := range addresses {
+		if !address.IsHostname() {
+			ret = append(ret, address)
+
+			continue
+		}
+
+		ips, err := net.DefaultResolver.LookupHost(ctx, address.Host())
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve %s: %w", address.Host(), err)
+		}
+
+		for _, ip := range ips {
+			resolved, err := address.WithHost(ip)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create domain address: %w", err)
+			}
+
+			ret = append(ret, resolved)
+		}
+	}
+
+	return ret, nil
+}
+
+// groupAddresses는 같은 host의 v1, v2 주소를 하나의 mon_host 항목으로 묶는다.
+// 항목의 순서는 addresses에서 host가 처음 나온 순서를 따른다. protocol이 없는 주소는 따로 둔다.
+func groupAddresses(addresses []*domain.Address) [][]string {
+	var (
+		groups  [][]*domain.Address
+		indexes = make(map[string]int)
+	)
+
+	for _, address := range addresses {
+		if address.Protocol() == domain.ProtocolAny {
+			groups = append(groups, []*domain.Address{address})
+
+			continue
+		}
+
+		index, ok := indexes[address.Host()]
+		if !ok {
+			index = len(groups)
+			indexes[address.Host()] = index
+
+			groups = append(groups, nil)
+		}
+
+		groups[index] = append(groups[index], address)
+	}
+
+	var ret [][]string
+
+	for _, group := range groups {
+		// ceph는 addrvec의 앞쪽 주소를 먼저 사용하므로 v2를 앞에 둔다.
+		slices.SortStableFunc(group, func(a, b *domain.Address) int {
+			return cmp.Compare(protocolOrder(a.Protocol()), protocolOrder(b.Protocol()))
+		})
+
+		var addrs []string
+		for _, address := range group {
+			addrs = append(addrs, address.String())
+		}
+
+		ret = append(ret, addrs)
+	}
+
+	return ret
+}
+
+func protocolOrder(protocol domain.Protocol) int {
+	switch protocol {
+	case domain.ProtocolV2:
+		return 0
+	case domain.ProtocolV1:
+		return 1
+	default:
+		return 2 //nolint:mnd
+	}
 }
@@ -0,0 +1,95 @@
+package core_test
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/client/core"
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/pkg/cephcli"
+)
+
+// newProbedCluster는 hosts를 latencies 순서의 응답 시간으로 probe한 cluster를 만든다.
+func newProbedCluster(t *testing.T, hosts []string, latencies []time.Duration) *domain.Cluster {
+	t.Helper()
+
+	addresses, err := domain.NewAddressesFromHosts(hosts)
+	if err != nil {
+		t.Fatalf("failed to create hosts: %v", err)
+	}
+
+	now := time.Now()
+
+	var probes []*domain.MonitorProbe
+
+	for i, address := range addresses {
+		probe, err := domain.NewMonitorProbe(address, true, latencies[i], "", now)
+		if err != nil {
+			t.Fatalf("failed to create probe: %v", err)
+		}
+
+		probes = append(probes, probe)
+	}
+
+	cluster, err := domain.NewCluster(
+		"cluster-1", "test", "", addresses, nil, false, "client.admin", "AQ==",
+		nil, domain.ClusterStatusUnknown, now, "", domain.NewUnknownConnection(), nil, probes, nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create cluster: %v", err)
+	}
+
+	return cluster
+}
+
+func TestFactory_NewClient_KeepsSessionWhenMonitorOrderChanges(t *testing.T) {
+	t.Parallel()
+
+	runner := cephcli.NewFakeRunner()
+	runner.SetOutput([]byte(`{"status":"HEALTH_OK"}`), "health", "detail")
+
+	factory := core.NewFactory(runner, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	t.Cleanup(factory.Close)
+
+	hosts := []string{"v1:192.168.10.11:6789", "v1:192.168.10.12:6789"}
+
+	polls := []struct {
+		latencies []time.Duration
+		monHost   string
+	}{
+		{
+			latencies: []time.Duration{time.Millisecond, 2 * time.Millisecond},
+			monHost:   "v1:192.168.10.11:6789,v1:192.168.10.12:6789",
+		},
+		{
+			latencies: []time.Duration{3 * time.Millisecond, 2 * time.Millisecond},
+			monHost:   "v1:192.168.10.12:6789,v1:192.168.10.11:6789",
+		},
+	}
+
+	for i, poll := range polls {
+		testClient, err := factory.NewClient(t.Context(), newProbedCluster(t, hosts, poll.latencies))
+		if err != nil {
+			t.Fatalf("poll %d: NewClient() error = %v", i, err)
+		}
+
+		_, _, err = testClient.HealthCheck(t.Context())
+		testClient.Close()
+
+		if err != nil {
+			t.Fatalf("poll %d: HealthCheck() error = %v", i, err)
+		}
+
+		commands := runner.Commands()
+		if got := commands[len(commands)-1].MonHost; got != poll.monHost {
+			t.Errorf("poll %d: mon host = %q, want %q", i, got, poll.monHost)
+		}
+	}
+
+	stats := factory.Stats()
+	if stats.Misses != 1 || stats.Hits != 1 || stats.Invalidations != 0 {
+		t.Errorf("stats = %+v, want 1 miss, 1 hit, no invalidation", stats)
+	}
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/client"
+)
+
+// session은 한 cluster에 접근하기 위해 만들어 둔 ceph.conf와 keyring이다.
+type session struct {
+	path         string
+	entity       string
+	fingerprint  string
+	refs         int
+	invalid      bool
+	lastUsedTime time.Time
+}
+
+// pool은 cluster별 session을 보관한다.
+// 사용 중인 session이 무효가 되면 마지막 사용자가 반납할 때 지운다.
+type pool struct {
+	mu       sync.Mutex
+	sessions map[string]*session
+	// creating은 session을 만드는 중인 cluster이다. 만들기를 마치면 channel을 닫는다.
+	creating    map[string]chan struct{}
+	idleTimeout time.Duration
+	stats       client.PoolStats
+	logger      *slog.Logger
+}
+
+func newPool(idleTimeout time.Duration, logger *slog.Logger) *pool {
+	return &pool{ //nolint:exhaustruct
+		sessions:    make(map[string]*session),
+		creating:    make(map[string]chan struct{}),
+		idleTimeout: idleTimeout,
+		logger:      logger,
+	}
+}
+
+// acquire는 clusterID의 session을 빌려준다.
+// 보관 중인 session의 fingerprint가 다르면 버리고 create로 새로 만든다.
+// create는 파일을 쓰므로 lock 밖에서 부르고, 같은 cluster의 session을 만드는 중이면 끝나기를 기다렸다가 다시 확인한다.
+func (p *pool) acquire(
+	clusterID string,
+	fingerprint string,
+	now time.Time,
+	create func() (*session, error),
+) (*session, error) {
+	p.mu.Lock()
+
+	for {
+		p.evictIdle(now)
+
+		current, ok := p.sessions[clusterID]
+		if ok && current.fingerprint == fingerprint {
+			p.stats.Hits++
+			current.refs++
+			current.lastUsedTime = now
+			p.mu.Unlock()
+
+			return current, nil
+		}
+
+		done, ok := p.creating[clusterID]
+		if !ok {
+			break
+		}
+
+		p.mu.Unlock()
+		<-done
+		p.mu.Lock()
+	}
+
+	if current, ok := p.sessions[clusterID]; ok {
+		p.stats.Invalidations++
+		p.discard(clusterID, current)
+	}
+
+	done := make(chan struct{})
+	p.creating[clusterID] = done
+	p.mu.Unlock()
+
+	created, err := create()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.creating, clusterID)
+	close(done)
+
+	if err != nil {
+		return nil, err
+	}
+
+	p.stats.Misses++
+	created.fingerprint = fingerprint
+	created.refs = 1
+	created.lastUsedTime = now
+	p.sessions[clusterID] = created
+
+	return created, nil
+}
+
+func (p *pool) release(session *session, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	session.refs--
+	session.lastUsedTime = now
+
+	if session.invalid && session.refs == 0 {
+		p.removeSession(session)
+	}
+}
+
+func (p *pool) snapshot() client.PoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ret := p.stats
+	ret.Sessions = len(p.sessions)
+
+	return ret
+}
+
+func (p *pool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for clusterID, session := range p.sessions {
+		p.discard(clusterID, session)
+	}
+}
+
+// evictIdle은 idleTimeout 동안 쓰이지 않은 session을 버린다. 삭제된 cluster의 session도 이렇게 정리된다.
+func (p *pool) evictIdle(now time.Time) {
+	for clusterID, session := range p.sessions {
+		if session.refs > 0 || now.Sub(session.lastUsedTime) < p.idleTimeout {
+			continue
+		}
+
+		p.stats.Evictions++
+		p.discard(clusterID, session)
+	}
+}
+
+func (p *pool) discard(clusterID string, session *session) {
+	delete(p.sessions, clusterID)
+
+	session.invalid = true
+	if session.refs == 0 {
+		p.removeSession(session)
+	}
+}
+
+func (p *pool) removeSession(session *session) {
+	err := os.RemoveAll(session.path)
+	if err != nil {
+		p.logger.Warn("failed to remove session directory", "path", session.path, "error", err)
+	}
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errCreate = errors.New("create failed")
+
+func newTestPool() *pool {
+	return newPool(time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestPool_Acquire_DoesNotBlockOtherClustersWhileCreating(t *testing.T) {
+	t.Parallel()
+
+	testPool := newTestPool()
+	now := time.Now()
+
+	started := make(chan struct{})
+	unblock := make(chan struct{})
+	acquired := make(chan error)
+
+	go func() {
+		_, err := testPool.acquire("cluster-a", "a", now, func() (*session, error) {
+			close(started)
+			<-unblock
+
+			return &session{path: t.TempDir()}, nil //nolint:exhaustruct
+		})
+		acquired <- err
+	}()
+
+	<-started
+
+	// cluster-a의 session을 만드는 동안에도 다른 cluster는 기다리지 않는다.
+	_, err := testPool.acquire("cluster-b", "b", now, func() (*session, error) {
+		return &session{path: t.TempDir()}, nil //nolint:exhaustruct
+	})
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	close(unblock)
+
+	err = <-acquired
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+}
+
+func TestPool_Acquire_WaitsForSameCluster(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		firstErr    error
+		wantCreates int32
+		wantMisses  uint64
+		wantHits    uint64
+	}{
+		{name: "first create succeeds", firstErr: nil, wantCreates: 1, wantMisses: 1, wantHits: 1},
+		{name: "first create fails", firstErr: errCreate, wantCreates: 2, wantMisses: 1, wantHits: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			testPool := newTestPool()
+			now := time.Now()
+
+			var creates atomic.Int32
+
+			started := make(chan struct{})
+			unblock := make(chan struct{})
+			first := make(chan error)
+
+			go func() {
+				_, err := testPool.acquire("cluster-a", "a", now, func() (*session, error) {
+					creates.Add(1)
+					close(started)
+					<-unblock
+
+					if tt.firstErr != nil {
+						return nil, tt.firstErr
+					}
+
+					return &session{path: t.TempDir()}, nil //nolint:exhaustruct
+				})
+				first <- err
+			}()
+
+			<-started
+
+			second := make(chan error)
+
+			go func() {
+				_, err := testPool.acquire("cluster-a", "a", now, func() (*session, error) {
+					creates.Add(1)
+
+					return &session{path: t.TempDir()}, nil //nolint:exhaustruct
+				})
+				second <- err
+			}()
+
+			close(unblock)
+
+			if err := <-first; !errors.Is(err, tt.firstErr) {
+				t.Errorf("first acquire() error = %v, want %v", err, tt.firstErr)
+			}
+
+			if err := <-second; err != nil {
+				t.Errorf("second acquire() error = %v", err)
+			}
+
+			if got := creates.Load(); got != tt.wantCreates {
+				t.Errorf("creates = %d, want %d", got, tt.wantCreates)
+			}
+
+			stats := testPool.snapshot()
+			if stats.Misses != tt.wantMisses || stats.Hits != tt.wantHits || stats.Sessions != 1 {
+				t.Errorf("stats = %+v, want %d miss, %d hit, 1 session", stats, tt.wantMisses, tt.wantHits)
+			}
+		})
+	}
+}
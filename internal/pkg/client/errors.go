@@ -0,0 +1,10 @@
+package client
+
+import "errors"
+
+var (
+	ErrAuthFailure = errors.New("authentication failure")
+	ErrTimeout     = errors.New("timeout")
+	ErrQuorumLost  = errors.New("monitor quorum lost")
+	ErrRuntime     = errors.New("container runtime error")
+)
@@ -0,0 +1,15 @@
+package client
+
+// PoolStats는 Factory가 재사용하는 cluster별 session의 현황이다.
+type PoolStats struct {
+	// Sessions는 현재 유지 중인 session 수이다.
+	Sessions int
+	// Hits는 기존 session을 재사용한 횟수이다.
+	Hits uint64
+	// Misses는 session을 새로 만든 횟수이다.
+	Misses uint64
+	// Invalidations는 hosts나 key가 바뀌어 session을 버린 횟수이다.
+	Invalidations uint64
+	// Evictions는 오래 쓰이지 않아 session을 버린 횟수이다.
+	Evictions uint64
+}
@@ -4,14 +4,48 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"strings"
 )
 
+// Protocol은 monitor와 통신할 messenger 버전이다.
+type Protocol string
+
+const (
+	// ProtocolAny는 버전을 지정하지 않은 것이다. ceph가 port를 보고 정한다.
+	ProtocolAny Protocol = ""
+	ProtocolV1  Protocol = "v1"
+	ProtocolV2  Protocol = "v2"
+)
+
+func (p Protocol) validate() error {
+	switch p {
+	case ProtocolAny, ProtocolV1, ProtocolV2:
+		return nil
+	default:
+		return InvalidParameterError("protocol")
+	}
+}
+
 type Address struct {
-	host string
-	port int
+	protocol Protocol
+	host     string
+	port     int
 }
 
+// NewAddressFromHost는 "v2:10.0.0.1:3300", "[::1]:6789", "mon1.example.com:6789" 형태의 주소를 해석한다.
 func NewAddressFromHost(host string) (*Address, error) {
+	protocol := ProtocolAny
+
+	for _, candidate := range []Protocol{ProtocolV1, ProtocolV2} {
+		rest, ok := strings.CutPrefix(host, string(candidate)+":")
+		if ok {
+			protocol = candidate
+			host = rest
+
+			break
+		}
+	}
+
 	host, port, err := net.SplitHostPort(host)
 	if err != nil {
 		return nil, InvalidParameterError("host")
@@ -22,16 +56,17 @@ func NewAddressFromHost(host string) (*Address, error) {
 		return nil, InvalidParameterError("port")
 	}
 
-	return NewAddress(host, portInt)
+	return NewAddress(protocol, host, portInt)
 }
 
+// NewAddressesFromHosts는 주소 목록을 해석한다. 잘못된 주소가 있으면 "hosts[i]" 항목의 오류를 반환한다.
 func NewAddressesFromHosts(hosts []string) ([]*Address, error) {
 	var ret []*Address
 
-	for _, host := range hosts {
+	for i, host := range hosts {
 		address, err := NewAddressFromHost(host)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("%w: %q: %w", InvalidParameterError(fmt.Sprintf("hosts[%d]", i)), host, err)
 		}
 
 		ret = append(ret, address)
@@ -40,12 +75,17 @@ func NewAddressesFromHosts(hosts []string) ([]*Address, error) {
 	return ret, nil
 }
 
-func NewAddress(host string, port int) (*Address, error) {
+func NewAddress(protocol Protocol, host string, port int) (*Address, error) {
+	err := protocol.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	if host == "" {
 		return nil, InvalidParameterError("host")
 	}
 
-	if net.ParseIP(host) == nil {
+	if net.ParseIP(host) == nil && !isHostname(host) {
 		return nil, InvalidParameterError("host")
 	}
 
@@ -54,11 +94,87 @@ func NewAddress(host string, port int) (*Address, error) {
 	}
 
 	return &Address{
-		host: host,
-		port: port,
+		protocol: protocol,
+		host:     host,
+		port:     port,
 	}, nil
 }
 
+func (a *Address) Protocol() Protocol {
+	return a.protocol
+}
+
+func (a *Address) Host() string {
+	return a.host
+}
+
+func (a *Address) Port() int {
+	return a.port
+}
+
+// IsHostname은 host가 IP가 아니라 DNS 이름인지 여부이다. DNS 이름은 접속할 때 해석한다.
+func (a *Address) IsHostname() bool {
+	return net.ParseIP(a.host) == nil
+}
+
+// WithHost는 protocol과 port는 그대로 두고 host만 바꾼 주소를 반환한다.
+func (a *Address) WithHost(host string) (*Address, error) {
+	return NewAddress(a.protocol, host, a.port)
+}
+
+// HostPort는 protocol을 뺀 "host:port" 형태이다. IPv6는 대괄호로 감싼다.
+func (a *Address) HostPort() string {
+	return net.JoinHostPort(a.host, strconv.Itoa(a.port))
+}
+
 func (a *Address) String() string {
-	return fmt.Sprintf("%s:%d", a.host, a.port)
+	if a.protocol == ProtocolAny {
+		return a.HostPort()
+	}
+
+	return fmt.Sprintf("%s:%s", a.protocol, a.HostPort())
+}
+
+func (a *Address) equal(other *Address) bool {
+	return a.protocol == other.protocol &&
+		a.host == other.host &&
+		a.port == other.port
+}
+
+// isHostname은 RFC 1123 형식의 DNS 이름인지 확인한다.
+func isHostname(host string) bool {
+	const (
+		maxLength      = 253
+		maxLabelLength = 63
+	)
+
+	host = strings.TrimSuffix(host, ".")
+	if host == "" || len(host) > maxLength {
+		return false
+	}
+
+	// 숫자로만 된 최상위 label은 잘못 쓴 IP로 본다. (RFC 3696)
+	tld := host[strings.LastIndex(host, ".")+1:]
+	if strings.Trim(tld, "0123456789") == "" {
+		return false
+	}
+
+	for label := range strings.SplitSeq(host, ".") {
+		if label == "" || len(label) > maxLabelLength {
+			return false
+		}
+
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for _, r := range label {
+			isAlnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+			if !isAlnum && r != '-' {
+				return false
+			}
+		}
+	}
+
+	return true
 }
@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionClusterRegistered AuditAction = "CLUSTER_REGISTERED"
+	AuditActionHostsUpdated      AuditAction = "HOSTS_UPDATED"
+	AuditActionHostsReplaced     AuditAction = "HOSTS_REPLACED"
+	AuditActionHostsRolledBack   AuditAction = "HOSTS_ROLLED_BACK"
+	AuditActionCommandRun        AuditAction = "COMMAND_RUN"
+	AuditActionClusterImported   AuditAction = "CLUSTER_IMPORTED"
+	AuditActionClusterUpdated    AuditAction = "CLUSTER_UPDATED"
+)
+
+// NewAuditAction은 문자열이 알려진 action인지 확인한다.
+func NewAuditAction(value string) (AuditAction, error) {
+	ret := AuditAction(value)
+
+	err := ret.validate()
+	if err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
+func (a AuditAction) validate() error {
+	switch a {
+	case AuditActionClusterRegistered,
+		AuditActionHostsUpdated,
+		AuditActionHostsReplaced,
+		AuditActionHostsRolledBack,
+		AuditActionCommandRun,
+		AuditActionClusterImported,
+		AuditActionClusterUpdated:
+		return nil
+	default:
+		return InvalidParameterError("action")
+	}
+}
+
+// FieldChange는 cluster 설정 한 항목의 변경 전후 값이다.
+type FieldChange struct {
+	field  string
+	before string
+	after  string
+}
+
+func NewFieldChange(field, before, after string) (*FieldChange, error) {
+	if field == "" {
+		return nil, InvalidParameterError("field")
+	}
+
+	return &FieldChange{
+		field:  field,
+		before: before,
+		after:  after,
+	}, nil
+}
+
+func (c *FieldChange) Field() string {
+	return c.field
+}
+
+func (c *FieldChange) Before() string {
+	return c.before
+}
+
+func (c *FieldChange) After() string {
+	return c.after
+}
+
+// redacted는 key처럼 감사 기록에도 남기면 안 되는 값 대신 쓴다.
+const redacted = "<redacted>"
+
+// DiffClusters는 before에서 after로 바뀐 설정 항목을 반환한다.
+// before가 nil이면 새로 만든 cluster로 보고 after의 모든 항목을 반환한다. key 값은 남기지 않는다.
+func DiffClusters(before, after *Cluster) []*FieldChange {
+	fields := func(cluster *Cluster) map[string]string {
+		if cluster == nil {
+			return map[string]string{}
+		}
+
+		return map[string]string{
+			"name":           cluster.name,
+			"fsid":           cluster.fsid,
+			"hosts":          joinAddresses(cluster.hosts),
+			"fallback_hosts": joinAddresses(cluster.fallbackHosts),
+			"hosts_pinned":   strconv.FormatBool(cluster.hostsPinned),
+			"entity":         cluster.entity,
+			"key":            cluster.key,
+			"labels":         formatLabels(cluster.labels),
+		}
+	}
+
+	beforeFields := fields(before)
+	afterFields := fields(after)
+
+	var ret []*FieldChange
+
+	for _, field := range slices.Sorted(maps.Keys(afterFields)) {
+		beforeValue, afterValue := beforeFields[field], afterFields[field]
+		if beforeValue == afterValue {
+			continue
+		}
+
+		if field == "key" {
+			if beforeValue != "" {
+				beforeValue = redacted
+			}
+
+			afterValue = redacted
+		}
+
+		ret = append(ret, &FieldChange{
+			field:  field,
+			before: beforeValue,
+			after:  afterValue,
+		})
+	}
+
+	return ret
+}
+
+func joinAddresses(addresses []*Address) string {
+	var ret []string
+	for _, address := range addresses {
+		ret = append(ret, address.String())
+	}
+
+	return strings.Join(ret, ",")
+}
+
+// AuditEntry는 누가 언제 어떤 cluster에 무엇을 했는지에 대한 기록이다. 한 번 기록하면 바꾸지 않는다.
+type AuditEntry struct {
+	id        string
+	time      time.Time
+	actor     string
+	clusterID string
+	action    AuditAction
+	detail    string
+	changes   []*FieldChange
+}
+
+func NewAuditEntry(
+	id string,
+	time time.Time,
+	actor string,
+	clusterID string,
+	action AuditAction,
+	detail string,
+	changes []*FieldChange,
+) (*AuditEntry, error) {
+	ret := AuditEntry{
+		id:        id,
+		time:      time,
+		actor:     actor,
+		clusterID: clusterID,
+		action:    action,
+		detail:    detail,
+		changes:   slices.Clone(changes),
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (e *AuditEntry) ID() string {
+	return e.id
+}
+
+func (e *AuditEntry) Time() time.Time {
+	return e.time
+}
+
+func (e *AuditEntry) Actor() string {
+	return e.actor
+}
+
+func (e *AuditEntry) ClusterID() string {
+	return e.clusterID
+}
+
+func (e *AuditEntry) Action() AuditAction {
+	return e.action
+}
+
+func (e *AuditEntry) Detail() string {
+	return e.detail
+}
+
+func (e *AuditEntry) Changes() []*FieldChange {
+	return slices.Clone(e.changes)
+}
+
+func (e *AuditEntry) validate() error {
+	if e.id == "" {
+		return InvalidParameterError("id")
+	}
+
+	if e.time.IsZero() {
+		return InvalidParameterError("time")
+	}
+
+	if e.actor == "" {
+		return InvalidParameterError("actor")
+	}
+
+	if e.clusterID == "" {
+		return InvalidParameterError("clusterID")
+	}
+
+	err := e.action.validate()
+	if err != nil {
+		return err
+	}
+
+	for _, change := range e.changes {
+		if change == nil {
+			return InvalidParameterError("changes")
+		}
+	}
+
+	return nil
+}
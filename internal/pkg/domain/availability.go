@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"time"
+)
+
+// Availability는 기간 동안 cluster가 각 status에 머문 시간과 장애 통계이다.
+// 장애는 HEALTH_OK가 아닌 status로 바뀐 때부터 다시 HEALTH_OK가 될 때까지이다.
+type Availability struct {
+	since     time.Time
+	until     time.Time
+	durations map[ClusterStatus]time.Duration
+	incidents int
+	resolved  int
+	repair    time.Duration
+}
+
+// ComputeAvailability는 발생 순으로 정렬된 status 변경 기록으로 since부터 until까지의 가용성을 계산한다.
+// 첫 기록 이전은 status를 알 수 없으므로 어느 status에도 넣지 않는다.
+func ComputeAvailability(changes []*StatusChange, since, until time.Time) (*Availability, error) {
+	if since.IsZero() {
+		return nil, InvalidParameterError("since")
+	}
+
+	if !until.After(since) {
+		return nil, InvalidParameterError("until")
+	}
+
+	ret := &Availability{
+		since:     since,
+		until:     until,
+		durations: map[ClusterStatus]time.Duration{},
+		incidents: 0,
+		resolved:  0,
+		repair:    0,
+	}
+
+	var (
+		current   ClusterStatus
+		lastTime  time.Time
+		startTime time.Time // 진행 중인 장애가 시작된 시각. 없으면 zero이다.
+	)
+
+	for _, change := range changes {
+		if !change.time.Before(until) {
+			break
+		}
+
+		if current != "" {
+			ret.add(current, lastTime, change.time)
+		}
+
+		switch {
+		case change.to.isHealthy() && !startTime.IsZero():
+			if !startTime.Before(since) {
+				ret.resolved++
+				ret.repair += change.time.Sub(startTime)
+			}
+
+			startTime = time.Time{}
+		case change.from.isHealthy() && !change.to.isHealthy():
+			startTime = change.time
+			if !startTime.Before(since) {
+				ret.incidents++
+			}
+		}
+
+		current = change.to
+		lastTime = change.time
+	}
+
+	if current != "" {
+		ret.add(current, lastTime, until)
+	}
+
+	return ret, nil
+}
+
+// add는 from부터 to까지 status였던 시간 중 기간에 포함되는 만큼을 더한다.
+func (a *Availability) add(status ClusterStatus, from, to time.Time) {
+	from = maxTime(from, a.since)
+	to = minTime(to, a.until)
+
+	if to.After(from) {
+		a.durations[status] += to.Sub(from)
+	}
+}
+
+func (a *Availability) Since() time.Time {
+	return a.since
+}
+
+func (a *Availability) Until() time.Time {
+	return a.until
+}
+
+// Duration은 기간 중 status였던 시간이다.
+func (a *Availability) Duration(status ClusterStatus) time.Duration {
+	return a.durations[status]
+}
+
+// Recorded는 기간 중 status를 알고 있는 시간이다.
+func (a *Availability) Recorded() time.Duration {
+	var ret time.Duration
+	for _, duration := range a.durations {
+		ret += duration
+	}
+
+	return ret
+}
+
+// Ratio는 status를 알고 있는 시간 중 status였던 비율이다. 알고 있는 시간이 없으면 0이다.
+func (a *Availability) Ratio(status ClusterStatus) float64 {
+	recorded := a.Recorded()
+	if recorded == 0 {
+		return 0
+	}
+
+	return float64(a.durations[status]) / float64(recorded)
+}
+
+// Incidents는 기간 중 시작한 장애의 수이다.
+func (a *Availability) Incidents() int {
+	return a.incidents
+}
+
+// ResolvedIncidents는 기간 중 시작해서 until 전에 끝난 장애의 수이다.
+func (a *Availability) ResolvedIncidents() int {
+	return a.resolved
+}
+
+// MTTR은 끝난 장애의 평균 지속 시간이다. 끝난 장애가 없으면 0이다.
+func (a *Availability) MTTR() time.Duration {
+	if a.resolved == 0 {
+		return 0
+	}
+
+	return a.repair / time.Duration(a.resolved)
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,228 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type change struct {
+	at       time.Duration
+	from, to domain.ClusterStatus
+}
+
+func newStatusChanges(t *testing.T, since time.Time, changes []change) []*domain.StatusChange {
+	t.Helper()
+
+	var ret []*domain.StatusChange
+
+	for _, c := range changes {
+		statusChange, err := domain.NewStatusChange("cluster-1", c.from, c.to, since.Add(c.at))
+		if err != nil {
+			t.Fatalf("failed to create status change: %v", err)
+		}
+
+		ret = append(ret, statusChange)
+	}
+
+	return ret
+}
+
+func TestComputeAvailability(t *testing.T) {
+	t.Parallel()
+
+	const (
+		ok      = domain.ClusterStatusHealthOK
+		warn    = domain.ClusterStatusHealthWarning
+		bad     = domain.ClusterStatusHealthError
+		pending = domain.ClusterStatusPending
+	)
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	hour := time.Hour
+
+	tests := []struct {
+		name          string
+		changes       []change
+		window        time.Duration
+		wantRecorded  time.Duration
+		wantDurations map[domain.ClusterStatus]time.Duration
+		wantIncidents int
+		wantResolved  int
+		wantMTTR      time.Duration
+	}{
+		{
+			name:          "no records",
+			changes:       nil,
+			window:        4 * hour,
+			wantRecorded:  0,
+			wantDurations: map[domain.ClusterStatus]time.Duration{},
+		},
+		{
+			name:          "healthy from before the window",
+			changes:       []change{{at: -hour, from: ok, to: ok}},
+			window:        4 * hour,
+			wantRecorded:  4 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{ok: 4 * hour},
+		},
+		{
+			name:          "first record inside the window",
+			changes:       []change{{at: hour, from: pending, to: pending}, {at: 2 * hour, from: pending, to: ok}},
+			window:        4 * hour,
+			wantRecorded:  3 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{pending: hour, ok: 2 * hour},
+		},
+		{
+			name: "resolved incident",
+			changes: []change{
+				{at: -hour, from: ok, to: ok},
+				{at: hour, from: ok, to: warn},
+				{at: 3 * hour, from: warn, to: ok},
+			},
+			window:        4 * hour,
+			wantRecorded:  4 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{ok: 2 * hour, warn: 2 * hour},
+			wantIncidents: 1,
+			wantResolved:  1,
+			wantMTTR:      2 * hour,
+		},
+		{
+			name: "worse status within one incident",
+			changes: []change{
+				{at: -hour, from: ok, to: ok},
+				{at: hour, from: ok, to: warn},
+				{at: 2 * hour, from: warn, to: bad},
+				{at: 3 * hour, from: bad, to: ok},
+			},
+			window:        4 * hour,
+			wantRecorded:  4 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{ok: 2 * hour, warn: hour, bad: hour},
+			wantIncidents: 1,
+			wantResolved:  1,
+			wantMTTR:      2 * hour,
+		},
+		{
+			name: "unresolved incident",
+			changes: []change{
+				{at: -hour, from: ok, to: ok},
+				{at: hour, from: ok, to: bad},
+			},
+			window:        4 * hour,
+			wantRecorded:  4 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{ok: hour, bad: 3 * hour},
+			wantIncidents: 1,
+			wantResolved:  0,
+			wantMTTR:      0,
+		},
+		{
+			name: "incident started before the window",
+			changes: []change{
+				{at: -2 * hour, from: ok, to: warn},
+				{at: hour, from: warn, to: ok},
+			},
+			window:        4 * hour,
+			wantRecorded:  4 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{warn: hour, ok: 3 * hour},
+			wantIncidents: 0,
+			wantResolved:  0,
+			wantMTTR:      0,
+		},
+		{
+			name: "resolved after the window",
+			changes: []change{
+				{at: -hour, from: ok, to: ok},
+				{at: hour, from: ok, to: warn},
+				{at: 5 * hour, from: warn, to: ok},
+			},
+			window:        4 * hour,
+			wantRecorded:  4 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{ok: hour, warn: 3 * hour},
+			wantIncidents: 1,
+			wantResolved:  0,
+			wantMTTR:      0,
+		},
+		{
+			name: "mean of two incidents",
+			changes: []change{
+				{at: 0, from: ok, to: ok},
+				{at: hour, from: ok, to: warn},
+				{at: 2 * hour, from: warn, to: ok},
+				{at: 3 * hour, from: ok, to: bad},
+				{at: 6 * hour, from: bad, to: ok},
+			},
+			window:        8 * hour,
+			wantRecorded:  8 * hour,
+			wantDurations: map[domain.ClusterStatus]time.Duration{ok: 4 * hour, warn: hour, bad: 3 * hour},
+			wantIncidents: 2,
+			wantResolved:  2,
+			wantMTTR:      2 * hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.ComputeAvailability(newStatusChanges(t, since, tt.changes), since, since.Add(tt.window))
+			if err != nil {
+				t.Fatalf("ComputeAvailability() error = %v", err)
+			}
+
+			if got.Recorded() != tt.wantRecorded {
+				t.Errorf("recorded = %v, want %v", got.Recorded(), tt.wantRecorded)
+			}
+
+			var ratios float64
+
+			for _, status := range []domain.ClusterStatus{ok, warn, bad, domain.ClusterStatusUnknown, pending} {
+				if got.Duration(status) != tt.wantDurations[status] {
+					t.Errorf("duration of %s = %v, want %v", status, got.Duration(status), tt.wantDurations[status])
+				}
+
+				ratios += got.Ratio(status)
+			}
+
+			if tt.wantRecorded > 0 && (ratios < 0.999 || ratios > 1.001) {
+				t.Errorf("sum of ratios = %v, want 1", ratios)
+			}
+
+			if got.Incidents() != tt.wantIncidents || got.ResolvedIncidents() != tt.wantResolved {
+				t.Errorf("resolved/incidents = %d/%d, want %d/%d",
+					got.ResolvedIncidents(), got.Incidents(), tt.wantResolved, tt.wantIncidents)
+			}
+
+			if got.MTTR() != tt.wantMTTR {
+				t.Errorf("MTTR = %v, want %v", got.MTTR(), tt.wantMTTR)
+			}
+		})
+	}
+}
+
+func TestComputeAvailability_InvalidWindow(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		since time.Time
+		until time.Time
+	}{
+		{name: "zero since", since: time.Time{}, until: since},
+		{name: "until equals since", since: since, until: since},
+		{name: "until before since", since: since, until: since.Add(-time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := domain.ComputeAvailability(nil, tt.since, tt.until)
+			if !errors.Is(err, domain.ErrInvalidParameter) {
+				t.Errorf("ComputeAvailability() error = %v, want %v", err, domain.ErrInvalidParameter)
+			}
+		})
+	}
+}
@@ -1,37 +1,71 @@
 package domain
 
 import (
+	"fmt"
+	"maps"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 )
 
+// DefaultEntity는 entity를 지정하지 않은 cluster가 접속할 때 쓰는 ceph 사용자이다.
+const DefaultEntity = "client.admin"
+
 type Cluster struct {
-	id          string
-	name        string
-	hosts       []*Address
-	key         string
-	status      ClusterStatus
-	lastBadTime time.Time
-	detail      any
+	id            string
+	name          string
+	fsid          string
+	hosts         []*Address
+	fallbackHosts []*Address
+	hostsPinned   bool
+	entity        string
+	key           string
+	labels        map[string]string
+	status        ClusterStatus
+	lastBadTime   time.Time
+	detail        any
+	connection    *Connection
+	monitorMap    *MonitorMap
+	probes        []*MonitorProbe
+	versions      *Versions
 }
 
 func NewCluster(
 	id string,
 	name string,
+	fsid string,
 	hosts []*Address,
+	fallbackHosts []*Address,
+	hostsPinned bool,
+	entity string,
 	key string,
+	labels map[string]string,
 	status ClusterStatus,
 	lastBadTime time.Time,
 	detail any,
+	connection *Connection,
+	monitorMap *MonitorMap,
+	probes []*MonitorProbe,
+	versions *Versions,
 ) (*Cluster, error) {
 	ret := Cluster{
-		id:          id,
-		name:        name,
-		hosts:       hosts,
-		key:         key,
-		status:      status,
-		lastBadTime: lastBadTime,
-		detail:      detail,
+		id:            id,
+		name:          name,
+		fsid:          fsid,
+		hosts:         hosts,
+		fallbackHosts: fallbackHosts,
+		hostsPinned:   hostsPinned,
+		entity:        entity,
+		key:           key,
+		labels:        maps.Clone(labels),
+		status:        status,
+		lastBadTime:   lastBadTime,
+		detail:        detail,
+		connection:    connection,
+		monitorMap:    monitorMap,
+		probes:        probes,
+		versions:      versions,
 	}
 
 	err := ret.validate()
@@ -72,6 +106,7 @@ func (c *Cluster) SetStatus(status ClusterStatus, detail any, now time.Time) (*C
 	return ret, nil
 }
 
+// SetHosts는 hosts를 바꾸고, 이전 hosts는 fallback으로 남긴다.
 func (c *Cluster) SetHosts(hosts []*Address) (*Cluster, error) {
 	if reflect.DeepEqual(c.hosts, hosts) {
 		return c, nil
@@ -79,6 +114,126 @@ func (c *Cluster) SetHosts(hosts []*Address) (*Cluster, error) {
 
 	ret := c.clone()
 	ret.hosts = hosts
+	ret.fallbackHosts = c.hosts
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// RollbackHosts는 fallback hosts로 되돌린다. 되돌린 뒤에는 fallback이 없다.
+func (c *Cluster) RollbackHosts() (*Cluster, error) {
+	if len(c.fallbackHosts) == 0 {
+		return nil, InvalidParameterError("fallbackHosts")
+	}
+
+	ret := c.clone()
+	ret.hosts = c.fallbackHosts
+	ret.fallbackHosts = nil
+
+	return ret, nil
+}
+
+// PinHosts는 monmap에 따라 hosts가 자동으로 바뀌지 않도록 고정하거나 고정을 푼다.
+func (c *Cluster) PinHosts(pinned bool) *Cluster {
+	if c.hostsPinned == pinned {
+		return c
+	}
+
+	ret := c.clone()
+	ret.hostsPinned = pinned
+
+	return ret
+}
+
+// SetLabels는 label을 모두 labels로 바꾼다.
+func (c *Cluster) SetLabels(labels map[string]string) (*Cluster, error) {
+	if maps.Equal(c.labels, labels) {
+		return c, nil
+	}
+
+	ret := c.clone()
+	ret.labels = maps.Clone(labels)
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (c *Cluster) SetConnection(connection *Connection) (*Cluster, error) {
+	if connection == nil {
+		return nil, InvalidParameterError("connection")
+	}
+
+	if c.connection.equal(connection) {
+		return c, nil
+	}
+
+	ret := c.clone()
+	ret.connection = connection
+
+	return ret, nil
+}
+
+// SetMonitorMap은 monmap을 반영한다.
+// monmap의 fsid가 cluster의 fsid와 다르면 다른 cluster에 접근한 것이므로 ErrIdentityMismatch를 반환한다.
+// fsid를 모르는 cluster는 monmap의 fsid를 따른다.
+func (c *Cluster) SetMonitorMap(monitorMap *MonitorMap) (*Cluster, error) {
+	if monitorMap == nil {
+		return nil, InvalidParameterError("monitorMap")
+	}
+
+	if c.fsid != "" && c.fsid != monitorMap.fsid {
+		return nil, fmt.Errorf("%w: expected fsid %s but got %s", ErrIdentityMismatch, c.fsid, monitorMap.fsid)
+	}
+
+	if c.fsid == monitorMap.fsid && c.monitorMap.equal(monitorMap) {
+		return c, nil
+	}
+
+	ret := c.clone()
+	ret.fsid = monitorMap.fsid
+	ret.monitorMap = monitorMap
+
+	return ret, nil
+}
+
+func (c *Cluster) SetProbes(probes []*MonitorProbe) (*Cluster, error) {
+	for _, probe := range probes {
+		if probe == nil {
+			return nil, InvalidParameterError("probes")
+		}
+	}
+
+	// latency와 오류 내용, probe 시각은 poll마다 달라지므로 접속 여부가 바뀌었을 때만 반영한다.
+	// 반영하지 않으면 저장된 latency가 그대로이므로 PrioritizedHosts의 순서도 유지된다.
+	if slices.EqualFunc(c.probes, probes, (*MonitorProbe).sameReachability) {
+		return c, nil
+	}
+
+	ret := c.clone()
+	ret.probes = probes
+
+	return ret, nil
+}
+
+func (c *Cluster) SetVersions(versions *Versions) (*Cluster, error) {
+	if versions == nil {
+		return nil, InvalidParameterError("versions")
+	}
+
+	if versions.equal(c.versions) {
+		return c, nil
+	}
+
+	ret := c.clone()
+	ret.versions = versions
 
 	return ret, nil
 }
@@ -95,14 +250,44 @@ func (c *Cluster) Name() string {
 	return c.name
 }
 
+// Fsid는 cluster의 고유 식별자이다. 아직 확인하지 못했으면 빈 문자열이다.
+func (c *Cluster) Fsid() string {
+	return c.fsid
+}
+
 func (c *Cluster) Hosts() []*Address {
 	return c.hosts
 }
 
+// FallbackHosts는 hosts를 바꾸기 전에 사용하던 hosts이다. 새 hosts로 접근하지 못할 때 되돌리는 데 쓴다.
+func (c *Cluster) FallbackHosts() []*Address {
+	return c.fallbackHosts
+}
+
+// PrioritizedHosts는 접속에 사용할 순서대로 정렬한 hosts이다.
+// 마지막 probe 결과를 기준으로 접속이 확인된 monitor를 latency 순으로 앞에 둔다.
+func (c *Cluster) PrioritizedHosts() []*Address {
+	return prioritizeHosts(c.hosts, c.probes)
+}
+
+func (c *Cluster) HostsPinned() bool {
+	return c.hostsPinned
+}
+
+// Entity는 접속할 때 쓰는 ceph 사용자이다. 예: client.admin
+func (c *Cluster) Entity() string {
+	return c.entity
+}
+
 func (c *Cluster) Key() string {
 	return c.key
 }
 
+// Labels는 운영자가 붙인 key/value이다. 환경이나 지역처럼 cluster를 묶는 데 쓴다.
+func (c *Cluster) Labels() map[string]string {
+	return maps.Clone(c.labels)
+}
+
 func (c *Cluster) Status() ClusterStatus {
 	return c.status
 }
@@ -115,6 +300,34 @@ func (c *Cluster) Detail() any {
 	return c.detail
 }
 
+func (c *Cluster) Connection() *Connection {
+	return c.connection
+}
+
+// MonitorMap은 마지막으로 확인한 monmap이다. 아직 확인하지 못했으면 nil이다.
+func (c *Cluster) MonitorMap() *MonitorMap {
+	return c.monitorMap
+}
+
+// Probes는 마지막으로 monitor마다 접속해 본 결과이다.
+func (c *Cluster) Probes() []*MonitorProbe {
+	return c.probes
+}
+
+// Versions는 마지막으로 확인한 daemon 버전이다. 아직 확인하지 못했으면 nil이다.
+func (c *Cluster) Versions() *Versions {
+	return c.versions
+}
+
+// Release는 cluster의 ceph release이다. 아직 확인하지 못했으면 ReleaseUnknown이다.
+func (c *Cluster) Release() Release {
+	if c.versions == nil {
+		return ReleaseUnknown
+	}
+
+	return c.versions.release
+}
+
 func (c *Cluster) validate() error {
 	if c.id == "" {
 		return InvalidParameterError("id")
@@ -134,26 +347,72 @@ func (c *Cluster) validate() error {
 		}
 	}
 
+	for _, host := range c.fallbackHosts {
+		if host == nil {
+			return InvalidParameterError("fallbackHosts")
+		}
+	}
+
+	if !isClientEntity(c.entity) {
+		return InvalidParameterError("entity")
+	}
+
 	if c.key == "" {
 		return InvalidParameterError("key")
 	}
 
-	err := c.status.validate()
+	err := validateLabels(c.labels)
 	if err != nil {
 		return err
 	}
 
+	err = c.status.validate()
+	if err != nil {
+		return err
+	}
+
+	if c.connection == nil {
+		return InvalidParameterError("connection")
+	}
+
 	return nil
 }
 
 func (c *Cluster) clone() *Cluster {
 	return &Cluster{
-		id:          c.id,
-		name:        c.name,
-		hosts:       c.hosts,
-		key:         c.key,
-		status:      c.status,
-		lastBadTime: c.lastBadTime,
-		detail:      c.detail,
+		id:            c.id,
+		name:          c.name,
+		fsid:          c.fsid,
+		hosts:         c.hosts,
+		fallbackHosts: c.fallbackHosts,
+		hostsPinned:   c.hostsPinned,
+		entity:        c.entity,
+		key:           c.key,
+		labels:        c.labels,
+		status:        c.status,
+		lastBadTime:   c.lastBadTime,
+		detail:        c.detail,
+		connection:    c.connection,
+		monitorMap:    c.monitorMap,
+		probes:        c.probes,
+		versions:      c.versions,
 	}
 }
+
+// isClientEntity는 "client.<id>" 형태인지 확인한다.
+// entity는 keyring 파일 이름에 들어가므로 id에는 영문자, 숫자, ".", "_", "-"만 허용한다.
+func isClientEntity(entity string) bool {
+	id, ok := strings.CutPrefix(entity, "client.")
+	if !ok || id == "" || strings.HasPrefix(id, ".") {
+		return false
+	}
+
+	for _, r := range id {
+		isAlnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !isAlnum && r != '.' && r != '_' && r != '-' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -2,8 +2,9 @@ package domain
 
 import "time"
 
+// IsClusterStable은 now까지 HEALTH_OK가 일정 시간 이상 유지되었는지 여부이다.
 func IsClusterStable(cluster *Cluster, now time.Time) bool {
 	const stableInterval = 3 * time.Minute
 
-	return cluster.LastBadTime().Add(stableInterval).Before(now)
+	return cluster.Status().isHealthy() && cluster.LastBadTime().Add(stableInterval).Before(now)
 }
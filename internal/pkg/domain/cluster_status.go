@@ -7,8 +7,22 @@ const (
 	ClusterStatusHealthOK      ClusterStatus = "HEALTH_OK"
 	ClusterStatusHealthWarning ClusterStatus = "HEALTH_WARN"
 	ClusterStatusHealthError   ClusterStatus = "HEALTH_ERR"
+	// ClusterStatusPending은 비동기 등록을 요청했지만 아직 접속해 보지 않은 cluster이다.
+	ClusterStatusPending ClusterStatus = "PENDING"
 )
 
+// NewClusterStatus는 문자열이 알려진 status인지 확인한다.
+func NewClusterStatus(value string) (ClusterStatus, error) {
+	ret := ClusterStatus(value)
+
+	err := ret.validate()
+	if err != nil {
+		return "", err
+	}
+
+	return ret, nil
+}
+
 func (s ClusterStatus) isHealthy() bool {
 	return s == ClusterStatusHealthOK
 }
@@ -18,7 +32,8 @@ func (s ClusterStatus) validate() error {
 	case ClusterStatusUnknown,
 		ClusterStatusHealthOK,
 		ClusterStatusHealthWarning,
-		ClusterStatusHealthError:
+		ClusterStatusHealthError,
+		ClusterStatusPending:
 		return nil
 	default:
 		return InvalidParameterError("status")
@@ -0,0 +1,112 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type probeResult struct {
+	reachable bool
+	latency   time.Duration
+	lastError string
+}
+
+func newProbes(t *testing.T, hosts []*domain.Address, results []probeResult, now time.Time) []*domain.MonitorProbe {
+	t.Helper()
+
+	var ret []*domain.MonitorProbe
+
+	for i, host := range hosts {
+		probe, err := domain.NewMonitorProbe(host, results[i].reachable, results[i].latency, results[i].lastError, now)
+		if err != nil {
+			t.Fatalf("failed to create probe: %v", err)
+		}
+
+		ret = append(ret, probe)
+	}
+
+	return ret
+}
+
+func TestCluster_SetProbes(t *testing.T) {
+	t.Parallel()
+
+	hosts, err := domain.NewAddressesFromHosts([]string{"v1:10.0.0.1:6789", "v1:10.0.0.2:6789"})
+	if err != nil {
+		t.Fatalf("failed to create hosts: %v", err)
+	}
+
+	now := time.Now()
+
+	base, err := domain.NewCluster(
+		"cluster-1", "test", "", hosts, nil, false, "client.admin", "AQ==",
+		nil, domain.ClusterStatusUnknown, now, "", domain.NewUnknownConnection(), nil, nil, nil,
+	)
+	if err != nil {
+		t.Fatalf("failed to create cluster: %v", err)
+	}
+
+	stored, err := base.SetProbes(newProbes(t, hosts, []probeResult{
+		{reachable: true, latency: time.Millisecond},
+		{reachable: false, lastError: "connection refused"},
+	}, now))
+	if err != nil {
+		t.Fatalf("SetProbes() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		results   []probeResult
+		changed   bool
+		wantFirst string
+	}{
+		{
+			name: "latency jitter",
+			results: []probeResult{
+				{reachable: true, latency: 5 * time.Millisecond},
+				{reachable: false, lastError: "connection refused"},
+			},
+			changed:   false,
+			wantFirst: "v1:10.0.0.1:6789",
+		},
+		{
+			name: "different error",
+			results: []probeResult{
+				{reachable: true, latency: time.Millisecond},
+				{reachable: false, lastError: "i/o timeout"},
+			},
+			changed:   false,
+			wantFirst: "v1:10.0.0.1:6789",
+		},
+		{
+			name: "monitor came back faster",
+			results: []probeResult{
+				{reachable: true, latency: 5 * time.Millisecond},
+				{reachable: true, latency: time.Millisecond},
+			},
+			changed:   true,
+			wantFirst: "v1:10.0.0.2:6789",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := stored.SetProbes(newProbes(t, hosts, tt.results, now.Add(time.Minute)))
+			if err != nil {
+				t.Fatalf("SetProbes() error = %v", err)
+			}
+
+			if (got != stored) != tt.changed {
+				t.Errorf("changed = %t, want %t", got != stored, tt.changed)
+			}
+
+			if first := got.PrioritizedHosts()[0].String(); first != tt.wantFirst {
+				t.Errorf("first host = %s, want %s", first, tt.wantFirst)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// CommandSpec은 API로 실행할 수 있는 읽기 전용 ceph 커맨드이다.
+// prefix 뒤에 maxArgs개까지 인자를 더 받을 수 있다.
+type CommandSpec struct {
+	prefix  []string
+	maxArgs int
+	timeout time.Duration
+}
+
+func (s *CommandSpec) Prefix() string {
+	return strings.Join(s.prefix, " ")
+}
+
+func (s *CommandSpec) MaxArgs() int {
+	return s.maxArgs
+}
+
+func (s *CommandSpec) Timeout() time.Duration {
+	return s.timeout
+}
+
+const (
+	commandTimeout      = 30 * time.Second
+	heavyCommandTimeout = 2 * time.Minute
+)
+
+// allowedCommands에는 cluster 상태를 바꾸지 않고, key 같은 비밀 정보를 드러내지 않는 커맨드만 둔다.
+var allowedCommands = []*CommandSpec{ //nolint:gochecknoglobals
+	{prefix: []string{"status"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"health", "detail"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"df"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"df", "detail"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"versions"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"features"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"time-sync-status"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"quorum_status"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"mon", "dump"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"mon", "stat"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"mgr", "dump"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"mgr", "services"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"mgr", "module", "ls"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "tree"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "df"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "dump"}, maxArgs: 0, timeout: heavyCommandTimeout},
+	{prefix: []string{"osd", "perf"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "blocked-by"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "pool", "ls"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "pool", "ls", "detail"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "pool", "stats"}, maxArgs: 1, timeout: commandTimeout},
+	{prefix: []string{"osd", "pool", "autoscale-status"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "crush", "tree"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"osd", "crush", "rule", "dump"}, maxArgs: 1, timeout: commandTimeout},
+	{prefix: []string{"pg", "stat"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"pg", "dump_stuck"}, maxArgs: 2, timeout: heavyCommandTimeout}, //nolint:mnd
+	{prefix: []string{"pg", "ls"}, maxArgs: 3, timeout: heavyCommandTimeout},         //nolint:mnd
+	{prefix: []string{"pg", "dump"}, maxArgs: 1, timeout: heavyCommandTimeout},
+	{prefix: []string{"fs", "ls"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"fs", "dump"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"fs", "status"}, maxArgs: 1, timeout: commandTimeout},
+	{prefix: []string{"balancer", "status"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"crash", "ls"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"orch", "ls"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"orch", "ps"}, maxArgs: 0, timeout: commandTimeout},
+	{prefix: []string{"orch", "host", "ls"}, maxArgs: 0, timeout: commandTimeout},
+}
+
+// AllowedCommands는 실행할 수 있는 커맨드 목록이다.
+func AllowedCommands() []*CommandSpec {
+	return slices.Clone(allowedCommands)
+}
+
+// Command는 허용 목록에서 확인한 ceph 커맨드이다.
+type Command struct {
+	args []string
+	spec *CommandSpec
+}
+
+// NewCommand는 args가 허용된 커맨드인지 확인한다.
+// 가장 긴 prefix가 맞는 항목을 쓰며, 추가 인자는 옵션(-로 시작)이 될 수 없다.
+func NewCommand(args []string) (*Command, error) {
+	var spec *CommandSpec
+
+	for _, candidate := range allowedCommands {
+		if len(args) < len(candidate.prefix) || !slices.Equal(args[:len(candidate.prefix)], candidate.prefix) {
+			continue
+		}
+
+		if spec == nil || len(candidate.prefix) > len(spec.prefix) {
+			spec = candidate
+		}
+	}
+
+	if spec == nil {
+		return nil, fmt.Errorf("%w: %s", ErrCommandNotAllowed, strings.Join(args, " "))
+	}
+
+	extra := args[len(spec.prefix):]
+	if len(extra) > spec.maxArgs {
+		return nil, fmt.Errorf("%w: %s accepts at most %d arguments", ErrCommandNotAllowed, spec.Prefix(), spec.maxArgs)
+	}
+
+	for _, arg := range extra {
+		if arg == "" || strings.HasPrefix(arg, "-") {
+			return nil, fmt.Errorf("%w: invalid argument %q", ErrCommandNotAllowed, arg)
+		}
+	}
+
+	return &Command{
+		args: slices.Clone(args),
+		spec: spec,
+	}, nil
+}
+
+func (c *Command) Args() []string {
+	return slices.Clone(c.args)
+}
+
+func (c *Command) Timeout() time.Duration {
+	return c.spec.timeout
+}
+
+func (c *Command) String() string {
+	return strings.Join(c.args, " ")
+}
@@ -0,0 +1,94 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+func TestNewCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		args        string
+		wantTimeout time.Duration
+	}{
+		{name: "single word", args: "status", wantTimeout: 30 * time.Second},
+		{name: "longest prefix wins", args: "df detail", wantTimeout: 30 * time.Second},
+		{name: "deeper prefix", args: "osd pool ls detail", wantTimeout: 30 * time.Second},
+		{name: "heavy command", args: "osd dump", wantTimeout: 2 * time.Minute},
+		{name: "extra argument", args: "osd pool stats rbd", wantTimeout: 30 * time.Second},
+		{name: "all extra arguments", args: "pg ls 1 active clean", wantTimeout: 2 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.NewCommand(strings.Fields(tt.args))
+			if err != nil {
+				t.Fatalf("NewCommand() error = %v", err)
+			}
+
+			if got.String() != tt.args {
+				t.Errorf("String() = %q, want %q", got.String(), tt.args)
+			}
+
+			if got.Timeout() != tt.wantTimeout {
+				t.Errorf("Timeout() = %v, want %v", got.Timeout(), tt.wantTimeout)
+			}
+		})
+	}
+}
+
+func TestNewCommand_NotAllowed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "empty", args: nil},
+		{name: "unknown command", args: []string{"auth", "get", "client.admin"}},
+		{name: "mutating command", args: []string{"osd", "pool", "delete", "rbd"}},
+		{name: "prefix only partly matches", args: []string{"mon"}},
+		// "df"가 맞아도 "detail"이 아닌 인자는 받지 않는다.
+		{name: "argument to a command without arguments", args: []string{"df", "rbd"}},
+		{name: "too many arguments", args: []string{"osd", "pool", "stats", "rbd", "cephfs"}},
+		{name: "option as argument", args: []string{"osd", "pool", "stats", "--format=plain"}},
+		{name: "empty argument", args: []string{"osd", "pool", "stats", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := domain.NewCommand(tt.args)
+			if !errors.Is(err, domain.ErrCommandNotAllowed) {
+				t.Errorf("NewCommand() error = %v, want %v", err, domain.ErrCommandNotAllowed)
+			}
+		})
+	}
+}
+
+func TestNewCommand_CopiesArgs(t *testing.T) {
+	t.Parallel()
+
+	args := []string{"osd", "pool", "stats", "rbd"}
+
+	command, err := domain.NewCommand(args)
+	if err != nil {
+		t.Fatalf("NewCommand() error = %v", err)
+	}
+
+	args[3] = "--all"
+	command.Args()[3] = "--all"
+
+	if command.String() != "osd pool stats rbd" {
+		t.Errorf("String() = %q, want %q", command.String(), "osd pool stats rbd")
+	}
+}
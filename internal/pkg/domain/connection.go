@@ -0,0 +1,69 @@
+package domain
+
+import "time"
+
+// Connection은 cepher가 cluster에 접근할 수 있는지를 나타낸다.
+// cluster의 health와는 별개로 관리한다.
+type Connection struct {
+	reachability    Reachability
+	lastError       string
+	lastContactTime time.Time
+}
+
+func NewConnection(reachability Reachability, lastError string, lastContactTime time.Time) (*Connection, error) {
+	ret := Connection{
+		reachability:    reachability,
+		lastError:       lastError,
+		lastContactTime: lastContactTime,
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func NewUnknownConnection() *Connection {
+	return &Connection{
+		reachability:    ReachabilityUnknown,
+		lastError:       "",
+		lastContactTime: time.Time{},
+	}
+}
+
+func (c *Connection) Reachability() Reachability {
+	return c.reachability
+}
+
+func (c *Connection) LastError() string {
+	return c.lastError
+}
+
+func (c *Connection) LastContactTime() time.Time {
+	return c.lastContactTime
+}
+
+func (c *Connection) IsReachable() bool {
+	return c.reachability == ReachabilityReachable
+}
+
+func (c *Connection) equal(other *Connection) bool {
+	return c.reachability == other.reachability &&
+		c.lastError == other.lastError &&
+		c.lastContactTime.Equal(other.lastContactTime)
+}
+
+func (c *Connection) validate() error {
+	err := c.reachability.validate()
+	if err != nil {
+		return err
+	}
+
+	if c.reachability == ReachabilityReachable && c.lastError != "" {
+		return InvalidParameterError("lastError")
+	}
+
+	return nil
+}
@@ -2,13 +2,32 @@ package domain
 
 import (
 	"errors"
-	"fmt"
 )
 
 var (
-	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrInvalidParameter  = errors.New("invalid parameter")
+	ErrIdentityMismatch  = errors.New("cluster identity mismatch")
+	ErrCommandNotAllowed = errors.New("command not allowed")
 )
 
+// ParameterError는 잘못된 값이 들어온 항목을 알려준다. errors.Is(err, ErrInvalidParameter)를 만족한다.
+type ParameterError struct {
+	field string
+}
+
+func (e *ParameterError) Error() string {
+	return "invalid " + e.field + ": " + ErrInvalidParameter.Error()
+}
+
+func (e *ParameterError) Unwrap() error {
+	return ErrInvalidParameter
+}
+
+// Field는 잘못된 값이 들어온 항목의 이름이다.
+func (e *ParameterError) Field() string {
+	return e.field
+}
+
 func InvalidParameterError(param string) error {
-	return fmt.Errorf("invalid %s: %w", param, ErrInvalidParameter)
+	return &ParameterError{field: param}
 }
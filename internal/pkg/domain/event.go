@@ -0,0 +1,101 @@
+package domain
+
+import (
+	"time"
+)
+
+type EventKind string
+
+const (
+	EventKindMonitorLeftQuorum   EventKind = "MONITOR_LEFT_QUORUM"
+	EventKindMonitorJoinedQuorum EventKind = "MONITOR_JOINED_QUORUM"
+	EventKindMonmapEpochChanged  EventKind = "MONMAP_EPOCH_CHANGED"
+	EventKindIdentityMismatch    EventKind = "IDENTITY_MISMATCH"
+	EventKindHostsChanged        EventKind = "HOSTS_CHANGED"
+	EventKindHostsRejected       EventKind = "HOSTS_REJECTED"
+	EventKindHostsRolledBack     EventKind = "HOSTS_ROLLED_BACK"
+	EventKindReleaseChanged      EventKind = "RELEASE_CHANGED"
+)
+
+func (k EventKind) validate() error {
+	switch k {
+	case EventKindMonitorLeftQuorum,
+		EventKindMonitorJoinedQuorum,
+		EventKindMonmapEpochChanged,
+		EventKindIdentityMismatch,
+		EventKindHostsChanged,
+		EventKindHostsRejected,
+		EventKindHostsRolledBack,
+		EventKindReleaseChanged:
+		return nil
+	default:
+		return InvalidParameterError("kind")
+	}
+}
+
+// Event는 cluster에서 일어난, 사람이 알아야 하는 변화이다.
+type Event struct {
+	id        string
+	clusterID string
+	kind      EventKind
+	message   string
+	time      time.Time
+}
+
+func NewEvent(id string, clusterID string, kind EventKind, message string, time time.Time) (*Event, error) {
+	ret := Event{
+		id:        id,
+		clusterID: clusterID,
+		kind:      kind,
+		message:   message,
+		time:      time,
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (e *Event) ID() string {
+	return e.id
+}
+
+func (e *Event) ClusterID() string {
+	return e.clusterID
+}
+
+func (e *Event) Kind() EventKind {
+	return e.kind
+}
+
+func (e *Event) Message() string {
+	return e.message
+}
+
+func (e *Event) Time() time.Time {
+	return e.time
+}
+
+func (e *Event) validate() error {
+	if e.id == "" {
+		return InvalidParameterError("id")
+	}
+
+	if e.clusterID == "" {
+		return InvalidParameterError("clusterID")
+	}
+
+	err := e.kind.validate()
+	if err != nil {
+		return err
+	}
+
+	if e.time.IsZero() {
+		return InvalidParameterError("time")
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// ceph의 mon_osd_nearfull_ratio, mon_osd_full_ratio 기본값이다.
+const (
+	NearFullRatio = 0.85
+	FullRatio     = 0.95
+)
+
+type ForecastState string
+
+const (
+	ForecastStateReached      ForecastState = "REACHED"
+	ForecastStatePredicted    ForecastState = "PREDICTED"
+	ForecastStateNotPredicted ForecastState = "NOT_PREDICTED"
+)
+
+// Forecast는 사용률이 threshold를 넘는 시점에 대한 예측이다.
+type Forecast struct {
+	threshold float64
+	state     ForecastState
+	time      time.Time
+}
+
+func (f *Forecast) Threshold() float64 {
+	return f.threshold
+}
+
+func (f *Forecast) State() ForecastState {
+	return f.state
+}
+
+// Time은 PREDICTED일 때 예측 시점이고, 그 외에는 zero value이다.
+func (f *Forecast) Time() time.Time {
+	return f.time
+}
+
+// ForecastCluster는 cluster의 raw 사용률이 threshold를 넘는 시점을 예측한다.
+func ForecastCluster(samples []*UsageSample, threshold float64) *Forecast {
+	var points []ratioPoint
+	for _, sample := range samples {
+		points = append(points, ratioPoint{time: sample.collectedTime, ratio: sample.usedRatio})
+	}
+
+	return forecast(points, threshold)
+}
+
+// ForecastPool은 pool의 사용률이 threshold를 넘는 시점을 예측한다.
+func ForecastPool(samples []*UsageSample, name string, threshold float64) *Forecast {
+	var points []ratioPoint
+
+	for _, sample := range samples {
+		pool := sample.Pool(name)
+		if pool == nil {
+			continue
+		}
+
+		points = append(points, ratioPoint{time: sample.collectedTime, ratio: pool.usedRatio})
+	}
+
+	return forecast(points, threshold)
+}
+
+type ratioPoint struct {
+	time  time.Time
+	ratio float64
+}
+
+// forecast는 시간 순으로 정렬된 points에 선형 회귀를 적용해 threshold를 넘는 시점을 구한다.
+func forecast(points []ratioPoint, threshold float64) *Forecast {
+	notPredicted := &Forecast{threshold: threshold, state: ForecastStateNotPredicted, time: time.Time{}}
+
+	if len(points) == 0 {
+		return notPredicted
+	}
+
+	last := points[len(points)-1]
+	if last.ratio >= threshold {
+		return &Forecast{threshold: threshold, state: ForecastStateReached, time: time.Time{}}
+	}
+
+	const minPoints = 2
+	if len(points) < minPoints {
+		return notPredicted
+	}
+
+	origin := points[0].time
+
+	var sumX, sumY, sumXY, sumXX float64
+
+	for _, point := range points {
+		x := point.time.Sub(origin).Seconds()
+		sumX += x
+		sumY += point.ratio
+		sumXY += x * point.ratio
+		sumXX += x * x
+	}
+
+	n := float64(len(points))
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return notPredicted
+	}
+
+	slope := (n*sumXY - sumX*sumY) / denominator
+	if slope <= 0 {
+		return notPredicted
+	}
+
+	intercept := (sumY - slope*sumX) / n
+
+	seconds := (threshold - intercept) / slope
+	if math.IsInf(seconds, 0) || math.IsNaN(seconds) || seconds > float64(math.MaxInt64/int64(time.Second)) {
+		return notPredicted
+	}
+
+	predicted := origin.Add(time.Duration(seconds * float64(time.Second)))
+	if predicted.Before(last.time) {
+		predicted = last.time
+	}
+
+	return &Forecast{threshold: threshold, state: ForecastStatePredicted, time: predicted}
+}
@@ -0,0 +1,142 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+// newSamples는 origin부터 하루 간격으로 ratios의 사용률을 가진 sample을 만든다.
+// pool "rbd"의 사용률도 같은 값이다.
+func newSamples(t *testing.T, origin time.Time, ratios []float64) []*domain.UsageSample {
+	t.Helper()
+
+	var ret []*domain.UsageSample
+
+	for i, ratio := range ratios {
+		pool, err := domain.NewPoolUsage("rbd", 0, 0, 0, ratio)
+		if err != nil {
+			t.Fatalf("failed to create pool usage: %v", err)
+		}
+
+		collectedTime := origin.Add(time.Duration(i) * 24 * time.Hour)
+
+		sample, err := domain.NewUsageSample("cluster-1", collectedTime, 0, 0, ratio, []*domain.PoolUsage{pool})
+		if err != nil {
+			t.Fatalf("failed to create usage sample: %v", err)
+		}
+
+		ret = append(ret, sample)
+	}
+
+	return ret
+}
+
+func TestForecastCluster(t *testing.T) {
+	t.Parallel()
+
+	origin := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		ratios    []float64
+		wantState domain.ForecastState
+		wantTime  time.Time
+	}{
+		{
+			name:      "no samples",
+			ratios:    nil,
+			wantState: domain.ForecastStateNotPredicted,
+		},
+		{
+			name:      "single sample",
+			ratios:    []float64{0.5},
+			wantState: domain.ForecastStateNotPredicted,
+		},
+		{
+			name:      "already reached",
+			ratios:    []float64{0.5, 0.9},
+			wantState: domain.ForecastStateReached,
+		},
+		{
+			name:      "flat usage",
+			ratios:    []float64{0.5, 0.5, 0.5},
+			wantState: domain.ForecastStateNotPredicted,
+		},
+		{
+			name:      "decreasing usage",
+			ratios:    []float64{0.6, 0.5, 0.4},
+			wantState: domain.ForecastStateNotPredicted,
+		},
+		{
+			name:      "linear growth",
+			ratios:    []float64{0.5, 0.6, 0.7},
+			wantState: domain.ForecastStatePredicted,
+			wantTime:  origin.Add(3*day + 12*time.Hour),
+		},
+		{
+			name:      "noisy growth",
+			ratios:    []float64{0.5, 0.7, 0.6, 0.8},
+			wantState: domain.ForecastStatePredicted,
+			wantTime:  origin.Add(4 * day),
+		},
+		{
+			// 회귀선이 이미 threshold를 지났어도 마지막 sample 전으로 예측하지 않는다.
+			name:      "regression crosses before last sample",
+			ratios:    []float64{0.1, 0.84, 0.84, 0.84},
+			wantState: domain.ForecastStatePredicted,
+			wantTime:  origin.Add(3 * day),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := domain.ForecastCluster(newSamples(t, origin, tt.ratios), domain.NearFullRatio)
+
+			if got.State() != tt.wantState {
+				t.Fatalf("state = %q, want %q", got.State(), tt.wantState)
+			}
+
+			if got.Threshold() != domain.NearFullRatio {
+				t.Errorf("threshold = %v, want %v", got.Threshold(), domain.NearFullRatio)
+			}
+
+			if diff := got.Time().Sub(tt.wantTime).Abs(); diff > time.Second {
+				t.Errorf("time = %v, want %v", got.Time(), tt.wantTime)
+			}
+		})
+	}
+}
+
+func TestForecastPool(t *testing.T) {
+	t.Parallel()
+
+	origin := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	samples := newSamples(t, origin, []float64{0.5, 0.6, 0.7})
+
+	tests := []struct {
+		name      string
+		pool      string
+		threshold float64
+		wantState domain.ForecastState
+	}{
+		{name: "growing pool", pool: "rbd", threshold: domain.FullRatio, wantState: domain.ForecastStatePredicted},
+		{name: "reached pool", pool: "rbd", threshold: 0.7, wantState: domain.ForecastStateReached},
+		{name: "unknown pool", pool: "cephfs", threshold: domain.FullRatio, wantState: domain.ForecastStateNotPredicted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := domain.ForecastPool(samples, tt.pool, tt.threshold)
+			if got.State() != tt.wantState {
+				t.Errorf("state = %q, want %q", got.State(), tt.wantState)
+			}
+		})
+	}
+}
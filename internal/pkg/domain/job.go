@@ -0,0 +1,347 @@
+package domain
+
+import (
+	"time"
+)
+
+type JobKind string
+
+const (
+	// JobKindRegisterCluster는 pending 상태로 저장한 cluster에 접속해 보고 등록을 마치는 작업이다.
+	JobKindRegisterCluster JobKind = "REGISTER_CLUSTER"
+)
+
+func (k JobKind) validate() error {
+	switch k {
+	case JobKindRegisterCluster:
+		return nil
+	default:
+		return InvalidParameterError("kind")
+	}
+}
+
+type JobState string
+
+const (
+	JobStatePending   JobState = "PENDING"
+	JobStateRunning   JobState = "RUNNING"
+	JobStateSucceeded JobState = "SUCCEEDED"
+	JobStateFailed    JobState = "FAILED"
+)
+
+func (s JobState) validate() error {
+	switch s {
+	case JobStatePending, JobStateRunning, JobStateSucceeded, JobStateFailed:
+		return nil
+	default:
+		return InvalidParameterError("state")
+	}
+}
+
+// IsFinished는 더 진행할 것이 없는 상태인지 여부이다.
+func (s JobState) IsFinished() bool {
+	return s == JobStateSucceeded || s == JobStateFailed
+}
+
+// JobStep은 job을 이루는 단계 하나의 결과이다.
+type JobStep struct {
+	name       string
+	state      JobState
+	message    string
+	startTime  time.Time
+	finishTime time.Time
+}
+
+func NewJobStep(name string, state JobState, message string, startTime, finishTime time.Time) (*JobStep, error) {
+	if name == "" {
+		return nil, InvalidParameterError("name")
+	}
+
+	err := state.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if startTime.IsZero() {
+		return nil, InvalidParameterError("startTime")
+	}
+
+	if state.IsFinished() == finishTime.IsZero() {
+		return nil, InvalidParameterError("finishTime")
+	}
+
+	return &JobStep{
+		name:       name,
+		state:      state,
+		message:    message,
+		startTime:  startTime,
+		finishTime: finishTime,
+	}, nil
+}
+
+func (s *JobStep) Name() string {
+	return s.name
+}
+
+func (s *JobStep) State() JobState {
+	return s.state
+}
+
+// Message는 단계의 결과를 설명한다. 실패한 단계에서는 실패 이유이다.
+func (s *JobStep) Message() string {
+	return s.message
+}
+
+func (s *JobStep) StartTime() time.Time {
+	return s.startTime
+}
+
+// FinishTime은 단계가 끝난 시각이다. 진행 중이면 zero이다.
+func (s *JobStep) FinishTime() time.Time {
+	return s.finishTime
+}
+
+// Job은 요청과 따로 background에서 진행하는 작업이다. 단계마다 결과를 남긴다.
+type Job struct {
+	id          string
+	kind        JobKind
+	clusterID   string
+	actor       string
+	state       JobState
+	steps       []*JobStep
+	createdTime time.Time
+	updatedTime time.Time
+}
+
+func NewJob(
+	id string,
+	kind JobKind,
+	clusterID string,
+	actor string,
+	state JobState,
+	steps []*JobStep,
+	createdTime time.Time,
+	updatedTime time.Time,
+) (*Job, error) {
+	ret := Job{
+		id:          id,
+		kind:        kind,
+		clusterID:   clusterID,
+		actor:       actor,
+		state:       state,
+		steps:       steps,
+		createdTime: createdTime,
+		updatedTime: updatedTime,
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+// StartStep은 새 단계를 시작한다. 진행 중인 단계가 있거나 job이 끝났으면 오류이다.
+func (j *Job) StartStep(name string, now time.Time) (*Job, error) {
+	if j.state.IsFinished() {
+		return nil, InvalidParameterError("state")
+	}
+
+	if j.currentStep() != nil {
+		return nil, InvalidParameterError("steps")
+	}
+
+	step, err := NewJobStep(name, JobStateRunning, "", now, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := j.clone()
+	ret.state = JobStateRunning
+	ret.steps = append(ret.steps[:len(ret.steps):len(ret.steps)], step)
+	ret.updatedTime = now
+
+	return ret, nil
+}
+
+// FinishStep은 진행 중인 단계를 성공으로 끝낸다.
+func (j *Job) FinishStep(message string, now time.Time) (*Job, error) {
+	return j.endStep(JobStateSucceeded, message, now)
+}
+
+// FailStep은 진행 중인 단계를 실패로 끝내고, job도 실패로 끝낸다.
+func (j *Job) FailStep(message string, now time.Time) (*Job, error) {
+	ret, err := j.endStep(JobStateFailed, message, now)
+	if err != nil {
+		return nil, err
+	}
+
+	ret.state = JobStateFailed
+
+	return ret, nil
+}
+
+// Fail은 job을 실패로 끝낸다. 진행 중인 단계가 있으면 그 단계를, 없으면 name 단계를 실패로 남긴다.
+func (j *Job) Fail(name string, message string, now time.Time) (*Job, error) {
+	if j.state.IsFinished() {
+		return nil, InvalidParameterError("state")
+	}
+
+	if j.currentStep() != nil {
+		return j.FailStep(message, now)
+	}
+
+	ret, err := j.StartStep(name, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret.FailStep(message, now)
+}
+
+// Restart는 중간에 멈춘 job을 처음 단계부터 다시 진행하도록 단계를 비운다. 끝난 job이면 오류이다.
+func (j *Job) Restart(now time.Time) (*Job, error) {
+	if j.state.IsFinished() {
+		return nil, InvalidParameterError("state")
+	}
+
+	if len(j.steps) == 0 {
+		return j, nil
+	}
+
+	ret := j.clone()
+	ret.state = JobStatePending
+	ret.steps = nil
+	ret.updatedTime = now
+
+	return ret, nil
+}
+
+// Succeed는 모든 단계를 마친 job을 성공으로 끝낸다.
+func (j *Job) Succeed(now time.Time) (*Job, error) {
+	if j.state.IsFinished() || j.currentStep() != nil {
+		return nil, InvalidParameterError("state")
+	}
+
+	ret := j.clone()
+	ret.state = JobStateSucceeded
+	ret.updatedTime = now
+
+	return ret, nil
+}
+
+func (j *Job) ID() string {
+	return j.id
+}
+
+func (j *Job) Kind() JobKind {
+	return j.kind
+}
+
+func (j *Job) ClusterID() string {
+	return j.clusterID
+}
+
+// Actor는 job을 요청한 사람이다. job이 끝나면 audit log에 남긴다.
+func (j *Job) Actor() string {
+	return j.actor
+}
+
+func (j *Job) State() JobState {
+	return j.state
+}
+
+// Steps는 시작한 순서대로의 단계이다.
+func (j *Job) Steps() []*JobStep {
+	return j.steps
+}
+
+func (j *Job) CreatedTime() time.Time {
+	return j.createdTime
+}
+
+func (j *Job) UpdatedTime() time.Time {
+	return j.updatedTime
+}
+
+func (j *Job) endStep(state JobState, message string, now time.Time) (*Job, error) {
+	current := j.currentStep()
+	if current == nil {
+		return nil, InvalidParameterError("steps")
+	}
+
+	step, err := NewJobStep(current.name, state, message, current.startTime, now)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := j.clone()
+	ret.steps = append(ret.steps[:len(ret.steps)-1:len(ret.steps)-1], step)
+	ret.updatedTime = now
+
+	return ret, nil
+}
+
+// currentStep은 진행 중인 단계이다. 없으면 nil이다.
+func (j *Job) currentStep() *JobStep {
+	if len(j.steps) == 0 {
+		return nil
+	}
+
+	last := j.steps[len(j.steps)-1]
+	if last.state.IsFinished() {
+		return nil
+	}
+
+	return last
+}
+
+func (j *Job) validate() error {
+	if j.id == "" {
+		return InvalidParameterError("id")
+	}
+
+	err := j.kind.validate()
+	if err != nil {
+		return err
+	}
+
+	if j.clusterID == "" {
+		return InvalidParameterError("clusterID")
+	}
+
+	err = j.state.validate()
+	if err != nil {
+		return err
+	}
+
+	for _, step := range j.steps {
+		if step == nil {
+			return InvalidParameterError("steps")
+		}
+	}
+
+	if j.createdTime.IsZero() {
+		return InvalidParameterError("createdTime")
+	}
+
+	if j.updatedTime.Before(j.createdTime) {
+		return InvalidParameterError("updatedTime")
+	}
+
+	return nil
+}
+
+func (j *Job) clone() *Job {
+	return &Job{
+		id:          j.id,
+		kind:        j.kind,
+		clusterID:   j.clusterID,
+		actor:       j.actor,
+		state:       j.state,
+		steps:       j.steps,
+		createdTime: j.createdTime,
+		updatedTime: j.updatedTime,
+	}
+}
@@ -0,0 +1,247 @@
+package domain_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+func newStep(t *testing.T, name string, state domain.JobState, now time.Time) *domain.JobStep {
+	t.Helper()
+
+	finishTime := time.Time{}
+	if state.IsFinished() {
+		finishTime = now
+	}
+
+	step, err := domain.NewJobStep(name, state, "", now, finishTime)
+	if err != nil {
+		t.Fatalf("failed to create step: %v", err)
+	}
+
+	return step
+}
+
+func newJob(t *testing.T, state domain.JobState, steps []*domain.JobStep, now time.Time) *domain.Job {
+	t.Helper()
+
+	job, err := domain.NewJob("job-1", domain.JobKindRegisterCluster, "cluster-1", "admin", state, steps, now, now)
+	if err != nil {
+		t.Fatalf("failed to create job: %v", err)
+	}
+
+	return job
+}
+
+func stepSummary(job *domain.Job) []string {
+	var ret []string
+
+	for _, step := range job.Steps() {
+		ret = append(ret, step.Name()+" "+string(step.State()))
+	}
+
+	return ret
+}
+
+func TestJob_Transitions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	later := now.Add(time.Second)
+
+	tests := []struct {
+		name      string
+		state     domain.JobState
+		steps     []string
+		running   bool
+		action    func(job *domain.Job) (*domain.Job, error)
+		wantErr   bool
+		wantState domain.JobState
+		wantSteps []string
+	}{
+		{
+			name:      "start first step",
+			state:     domain.JobStatePending,
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.StartStep("A", later) },
+			wantState: domain.JobStateRunning,
+			wantSteps: []string{"A RUNNING"},
+		},
+		{
+			name:    "start while a step is running",
+			state:   domain.JobStateRunning,
+			steps:   []string{"A"},
+			running: true,
+			action:  func(job *domain.Job) (*domain.Job, error) { return job.StartStep("B", later) },
+			wantErr: true,
+		},
+		{
+			name:      "finish running step",
+			state:     domain.JobStateRunning,
+			steps:     []string{"A"},
+			running:   true,
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.FinishStep("ok", later) },
+			wantState: domain.JobStateRunning,
+			wantSteps: []string{"A SUCCEEDED"},
+		},
+		{
+			name:    "finish without running step",
+			state:   domain.JobStateRunning,
+			steps:   []string{"A"},
+			action:  func(job *domain.Job) (*domain.Job, error) { return job.FinishStep("ok", later) },
+			wantErr: true,
+		},
+		{
+			name:      "fail running step",
+			state:     domain.JobStateRunning,
+			steps:     []string{"A"},
+			running:   true,
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.FailStep("boom", later) },
+			wantState: domain.JobStateFailed,
+			wantSteps: []string{"A FAILED"},
+		},
+		{
+			name:      "succeed after steps",
+			state:     domain.JobStateRunning,
+			steps:     []string{"A", "B"},
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.Succeed(later) },
+			wantState: domain.JobStateSucceeded,
+			wantSteps: []string{"A SUCCEEDED", "B SUCCEEDED"},
+		},
+		{
+			name:    "succeed while a step is running",
+			state:   domain.JobStateRunning,
+			steps:   []string{"A"},
+			running: true,
+			action:  func(job *domain.Job) (*domain.Job, error) { return job.Succeed(later) },
+			wantErr: true,
+		},
+		{
+			name:    "start after finished",
+			state:   domain.JobStateSucceeded,
+			steps:   []string{"A"},
+			action:  func(job *domain.Job) (*domain.Job, error) { return job.StartStep("B", later) },
+			wantErr: true,
+		},
+		{
+			name:      "fail closes running step",
+			state:     domain.JobStateRunning,
+			steps:     []string{"A", "B"},
+			running:   true,
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.Fail("C", "interrupted", later) },
+			wantState: domain.JobStateFailed,
+			wantSteps: []string{"A SUCCEEDED", "B FAILED"},
+		},
+		{
+			name:      "fail without running step",
+			state:     domain.JobStateRunning,
+			steps:     []string{"A"},
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.Fail("C", "cluster not found", later) },
+			wantState: domain.JobStateFailed,
+			wantSteps: []string{"A SUCCEEDED", "C FAILED"},
+		},
+		{
+			name:    "fail after finished",
+			state:   domain.JobStateFailed,
+			steps:   []string{"A"},
+			action:  func(job *domain.Job) (*domain.Job, error) { return job.Fail("C", "again", later) },
+			wantErr: true,
+		},
+		{
+			name:      "restart interrupted step",
+			state:     domain.JobStateRunning,
+			steps:     []string{"A", "B"},
+			running:   true,
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.Restart(later) },
+			wantState: domain.JobStatePending,
+			wantSteps: nil,
+		},
+		{
+			name:      "restart between steps",
+			state:     domain.JobStateRunning,
+			steps:     []string{"A"},
+			action:    func(job *domain.Job) (*domain.Job, error) { return job.Restart(later) },
+			wantState: domain.JobStatePending,
+			wantSteps: nil,
+		},
+		{
+			name:    "restart after finished",
+			state:   domain.JobStateSucceeded,
+			steps:   []string{"A"},
+			action:  func(job *domain.Job) (*domain.Job, error) { return job.Restart(later) },
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var steps []*domain.JobStep
+
+			for i, name := range tt.steps {
+				state := domain.JobStateSucceeded
+				if tt.running && i == len(tt.steps)-1 {
+					state = domain.JobStateRunning
+				}
+
+				steps = append(steps, newStep(t, name, state, now))
+			}
+
+			got, err := tt.action(newJob(t, tt.state, steps, now))
+			if tt.wantErr {
+				if !errors.Is(err, domain.ErrInvalidParameter) {
+					t.Fatalf("error = %v, want %v", err, domain.ErrInvalidParameter)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+
+			if got.State() != tt.wantState {
+				t.Errorf("state = %q, want %q", got.State(), tt.wantState)
+			}
+
+			if summary := stepSummary(got); !slices.Equal(summary, tt.wantSteps) {
+				t.Errorf("steps = %v, want %v", summary, tt.wantSteps)
+			}
+
+			if !got.UpdatedTime().Equal(later) {
+				t.Errorf("updated time = %v, want %v", got.UpdatedTime(), later)
+			}
+		})
+	}
+}
+
+func TestJob_Restart_StartsCleanStepList(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	job := newJob(t, domain.JobStateRunning, []*domain.JobStep{
+		newStep(t, "A", domain.JobStateSucceeded, now),
+		newStep(t, "B", domain.JobStateRunning, now),
+	}, now)
+
+	restarted, err := job.Restart(now.Add(time.Second))
+	if err != nil {
+		t.Fatalf("Restart() error = %v", err)
+	}
+
+	restarted, err = restarted.StartStep("A", now.Add(2*time.Second))
+	if err != nil {
+		t.Fatalf("StartStep() error = %v", err)
+	}
+
+	if summary := stepSummary(restarted); !slices.Equal(summary, []string{"A RUNNING"}) {
+		t.Errorf("steps = %v, want [A RUNNING]", summary)
+	}
+
+	if len(job.Steps()) != 2 {
+		t.Errorf("original steps = %d, want 2", len(job.Steps()))
+	}
+}
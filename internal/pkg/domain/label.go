@@ -0,0 +1,228 @@
+package domain
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+const maxLabelLength = 63
+
+// validateLabels는 label의 key와 value를 확인한다.
+// key는 "team"이나 "example.com/team"처럼 쓰고, value는 비어 있어도 된다.
+func validateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if !isLabelKey(key) {
+			return InvalidParameterError(fmt.Sprintf("labels[%s]", key))
+		}
+
+		if value != "" && !isLabelValue(value) {
+			return InvalidParameterError(fmt.Sprintf("labels[%s]", key))
+		}
+	}
+
+	return nil
+}
+
+// isLabelKey는 "<prefix>/<name>" 또는 "<name>" 형태인지 확인한다. prefix는 DNS 이름이다.
+func isLabelKey(key string) bool {
+	prefix, name, ok := strings.Cut(key, "/")
+	if !ok {
+		return isLabelValue(key)
+	}
+
+	return isHostname(prefix) && isLabelValue(name)
+}
+
+// isLabelValue는 63자 이하의 영문자, 숫자, "-", "_", "."로 이루어져 있고 영문자나 숫자로 시작하고 끝나는지 확인한다.
+func isLabelValue(value string) bool {
+	if value == "" || len(value) > maxLabelLength {
+		return false
+	}
+
+	isAlnum := func(b byte) bool {
+		return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+	}
+
+	if !isAlnum(value[0]) || !isAlnum(value[len(value)-1]) {
+		return false
+	}
+
+	for i := range len(value) {
+		if !isAlnum(value[i]) && value[i] != '-' && value[i] != '_' && value[i] != '.' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// formatLabels는 label을 key 순으로 "k=v,k=v" 형태로 나타낸다.
+func formatLabels(labels map[string]string) string {
+	var ret []string
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		ret = append(ret, key+"="+labels[key])
+	}
+
+	return strings.Join(ret, ",")
+}
+
+type selectorOperator string
+
+const (
+	selectorEquals       selectorOperator = "="
+	selectorNotEquals    selectorOperator = "!="
+	selectorIn           selectorOperator = "in"
+	selectorNotIn        selectorOperator = "notin"
+	selectorExists       selectorOperator = "exists"
+	selectorDoesNotExist selectorOperator = "!"
+)
+
+type selectorRequirement struct {
+	key      string
+	operator selectorOperator
+	values   []string
+}
+
+func (r *selectorRequirement) matches(labels map[string]string) bool {
+	value, ok := labels[r.key]
+
+	switch r.operator {
+	case selectorEquals, selectorIn:
+		return ok && slices.Contains(r.values, value)
+	case selectorNotEquals, selectorNotIn:
+		return !ok || !slices.Contains(r.values, value)
+	case selectorExists:
+		return ok
+	case selectorDoesNotExist:
+		return !ok
+	default:
+		return false
+	}
+}
+
+// LabelSelector는 label로 cluster를 고르는 조건이다. 모든 조건을 만족해야 한다.
+type LabelSelector struct {
+	requirements []*selectorRequirement
+}
+
+// ParseLabelSelector는 "env=prod,region!=us-east,team,!deprecated,tier in (web,db),zone notin (a)" 형태를 해석한다.
+// "=="는 "="와 같다. 빈 문자열은 모든 cluster를 고른다.
+func ParseLabelSelector(value string) (*LabelSelector, error) {
+	var requirements []*selectorRequirement
+
+	for _, term := range splitSelector(value) {
+		requirement, err := parseSelectorTerm(term)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", InvalidParameterError("selector"), term)
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	return &LabelSelector{
+		requirements: requirements,
+	}, nil
+}
+
+// Matches는 labels가 모든 조건을 만족하는지 여부이다.
+func (s *LabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range s.requirements {
+		if !requirement.matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitSelector는 괄호 밖의 ","로 조건을 나눈다.
+func splitSelector(value string) []string {
+	var (
+		ret   []string
+		depth int
+		start int
+	)
+
+	for i, r := range value {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			ret = append(ret, strings.TrimSpace(value[start:i]))
+			start = i + 1
+		}
+	}
+
+	if last := strings.TrimSpace(value[start:]); last != "" || len(ret) > 0 {
+		ret = append(ret, last)
+	}
+
+	return ret
+}
+
+func parseSelectorTerm(term string) (*selectorRequirement, error) {
+	if key, ok := strings.CutPrefix(term, "!"); ok {
+		return newSelectorRequirement(strings.TrimSpace(key), selectorDoesNotExist, nil)
+	}
+
+	for _, operator := range []selectorOperator{selectorNotEquals, "==", selectorEquals} {
+		key, value, ok := strings.Cut(term, string(operator))
+		if !ok {
+			continue
+		}
+
+		if operator == "==" {
+			operator = selectorEquals
+		}
+
+		return newSelectorRequirement(strings.TrimSpace(key), operator, []string{strings.TrimSpace(value)})
+	}
+
+	fields := strings.Fields(term)
+	if len(fields) == 1 {
+		return newSelectorRequirement(fields[0], selectorExists, nil)
+	}
+
+	for _, operator := range []selectorOperator{selectorNotIn, selectorIn} {
+		key, rest, ok := strings.Cut(term, " "+string(operator)+" ")
+		if !ok {
+			continue
+		}
+
+		rest = strings.TrimSpace(rest)
+		if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+			return nil, InvalidParameterError("selector")
+		}
+
+		var values []string
+		for item := range strings.SplitSeq(rest[1:len(rest)-1], ",") {
+			values = append(values, strings.TrimSpace(item))
+		}
+
+		return newSelectorRequirement(strings.TrimSpace(key), operator, values)
+	}
+
+	return nil, InvalidParameterError("selector")
+}
+
+func newSelectorRequirement(key string, operator selectorOperator, values []string) (*selectorRequirement, error) {
+	if !isLabelKey(key) {
+		return nil, InvalidParameterError("selector")
+	}
+
+	for _, value := range values {
+		if value != "" && !isLabelValue(value) {
+			return nil, InvalidParameterError("selector")
+		}
+	}
+
+	return &selectorRequirement{
+		key:      key,
+		operator: operator,
+		values:   values,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	prod := map[string]string{"env": "prod", "region": "eu-west", "team": "storage", "tier": "db"}
+	staging := map[string]string{"env": "staging", "region": "us-east", "deprecated": ""}
+	unlabeled := map[string]string{}
+
+	tests := []struct {
+		name     string
+		selector string
+		want     []bool // prod, staging, unlabeled 순서의 결과
+	}{
+		{name: "empty", selector: "", want: []bool{true, true, true}},
+		{name: "equals", selector: "env=prod", want: []bool{true, false, false}},
+		{name: "double equals", selector: "env==prod", want: []bool{true, false, false}},
+		{name: "not equals", selector: "region!=us-east", want: []bool{true, false, true}},
+		{name: "exists", selector: "team", want: []bool{true, false, false}},
+		{name: "does not exist", selector: "!deprecated", want: []bool{true, false, true}},
+		{name: "empty value", selector: "deprecated=", want: []bool{false, true, false}},
+		{name: "in", selector: "tier in (web, db)", want: []bool{true, false, false}},
+		{name: "notin", selector: "env notin (staging,dev)", want: []bool{true, false, true}},
+		{name: "prefixed key", selector: "example.com/owner!=alice", want: []bool{true, true, true}},
+		{name: "all terms", selector: "env=prod, team, tier in (db), !deprecated", want: []bool{true, false, false}},
+		{name: "spaces around operator", selector: " env = staging ", want: []bool{false, true, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			selector, err := domain.ParseLabelSelector(tt.selector)
+			if err != nil {
+				t.Fatalf("ParseLabelSelector() error = %v", err)
+			}
+
+			for i, labels := range []map[string]string{prod, staging, unlabeled} {
+				if got := selector.Matches(labels); got != tt.want[i] {
+					t.Errorf("Matches(%v) = %t, want %t", labels, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseLabelSelector_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		selector string
+	}{
+		{name: "empty term", selector: "env=prod,,team"},
+		{name: "trailing comma", selector: "env=prod,"},
+		{name: "missing key", selector: "=prod"},
+		{name: "invalid key", selector: "-env=prod"},
+		{name: "invalid value", selector: "env=prod!"},
+		{name: "value too long", selector: "env=" + strings.Repeat("a", 64)},
+		{name: "in without parentheses", selector: "tier in web"},
+		{name: "unknown operator", selector: "tier between (a,b)"},
+		{name: "invalid value in set", selector: "tier in (web,$db)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := domain.ParseLabelSelector(tt.selector)
+			if !errors.Is(err, domain.ErrInvalidParameter) {
+				t.Errorf("ParseLabelSelector() error = %v, want %v", err, domain.ErrInvalidParameter)
+			}
+		})
+	}
+}
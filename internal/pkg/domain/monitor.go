@@ -0,0 +1,261 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// MonitorMap은 mon dump로 얻은 monmap이다.
+type MonitorMap struct {
+	fsid          string
+	epoch         int
+	minMonRelease Release
+	monitors      []*Monitor
+}
+
+func NewMonitorMap(fsid string, epoch int, minMonRelease Release, monitors []*Monitor) (*MonitorMap, error) {
+	ret := MonitorMap{
+		fsid:          fsid,
+		epoch:         epoch,
+		minMonRelease: minMonRelease,
+		monitors:      slices.Clone(monitors),
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (m *MonitorMap) Fsid() string {
+	return m.fsid
+}
+
+func (m *MonitorMap) Epoch() int {
+	return m.epoch
+}
+
+// MinMonRelease는 모든 monitor가 보장하는 release(min_mon_release_name)이다. 알 수 없으면 빈 문자열이다.
+func (m *MonitorMap) MinMonRelease() Release {
+	return m.minMonRelease
+}
+
+func (m *MonitorMap) Monitors() []*Monitor {
+	return slices.Clone(m.monitors)
+}
+
+func (m *MonitorMap) Monitor(name string) *Monitor {
+	for _, monitor := range m.monitors {
+		if monitor.name == name {
+			return monitor
+		}
+	}
+
+	return nil
+}
+
+// Hosts는 모든 monitor의 addrvec 항목을 protocol을 포함한 주소로 반환한다.
+func (m *MonitorMap) Hosts() ([]*Address, error) {
+	var ret []*Address
+
+	for _, monitor := range m.monitors {
+		for _, addr := range monitor.addrs {
+			address, err := NewAddressFromHost(addr.addr)
+			if err != nil {
+				return nil, err
+			}
+
+			// addrvec의 type은 v1, v2 외에 any, none이 올 수 있다.
+			protocol := Protocol(addr.protocol)
+			if protocol.validate() != nil {
+				protocol = ProtocolAny
+			}
+
+			address, err = NewAddress(protocol, address.host, address.port)
+			if err != nil {
+				return nil, err
+			}
+
+			ret = append(ret, address)
+		}
+	}
+
+	return ret, nil
+}
+
+func (m *MonitorMap) equal(other *MonitorMap) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+
+	return m.fsid == other.fsid &&
+		m.epoch == other.epoch &&
+		m.minMonRelease == other.minMonRelease &&
+		slices.EqualFunc(m.monitors, other.monitors, (*Monitor).equal)
+}
+
+func (m *MonitorMap) validate() error {
+	if m.fsid == "" {
+		return InvalidParameterError("fsid")
+	}
+
+	if m.epoch < 0 {
+		return InvalidParameterError("epoch")
+	}
+
+	if len(m.monitors) == 0 {
+		return InvalidParameterError("monitors")
+	}
+
+	for _, monitor := range m.monitors {
+		if monitor == nil {
+			return InvalidParameterError("monitors")
+		}
+	}
+
+	return nil
+}
+
+type Monitor struct {
+	name     string
+	rank     int
+	addrs    []*MonitorAddress
+	inQuorum bool
+}
+
+func NewMonitor(name string, rank int, addrs []*MonitorAddress, inQuorum bool) (*Monitor, error) {
+	if name == "" {
+		return nil, InvalidParameterError("name")
+	}
+
+	if rank < 0 {
+		return nil, InvalidParameterError("rank")
+	}
+
+	if len(addrs) == 0 {
+		return nil, InvalidParameterError("addrs")
+	}
+
+	for _, addr := range addrs {
+		if addr == nil {
+			return nil, InvalidParameterError("addrs")
+		}
+	}
+
+	return &Monitor{
+		name:     name,
+		rank:     rank,
+		addrs:    slices.Clone(addrs),
+		inQuorum: inQuorum,
+	}, nil
+}
+
+func (m *Monitor) Name() string {
+	return m.name
+}
+
+func (m *Monitor) Rank() int {
+	return m.rank
+}
+
+func (m *Monitor) Addrs() []*MonitorAddress {
+	return slices.Clone(m.addrs)
+}
+
+func (m *Monitor) InQuorum() bool {
+	return m.inQuorum
+}
+
+func (m *Monitor) equal(other *Monitor) bool {
+	return m.name == other.name &&
+		m.rank == other.rank &&
+		m.inQuorum == other.inQuorum &&
+		slices.EqualFunc(m.addrs, other.addrs, func(a, b *MonitorAddress) bool {
+			return *a == *b
+		})
+}
+
+// MonitorAddress는 monitor의 addrvec 항목이다.
+type MonitorAddress struct {
+	protocol string
+	addr     string
+}
+
+func NewMonitorAddress(protocol string, addr string) (*MonitorAddress, error) {
+	if protocol == "" {
+		return nil, InvalidParameterError("protocol")
+	}
+
+	if addr == "" {
+		return nil, InvalidParameterError("addr")
+	}
+
+	return &MonitorAddress{
+		protocol: protocol,
+		addr:     addr,
+	}, nil
+}
+
+// Protocol은 messenger 버전이다. (v1, v2)
+func (a *MonitorAddress) Protocol() string {
+	return a.protocol
+}
+
+func (a *MonitorAddress) Addr() string {
+	return a.addr
+}
+
+// MonitorMapChange는 monmap이 바뀌면서 알려야 하는 변화이다.
+type MonitorMapChange struct {
+	kind    EventKind
+	message string
+}
+
+func (c *MonitorMapChange) Kind() EventKind {
+	return c.kind
+}
+
+func (c *MonitorMapChange) Message() string {
+	return c.message
+}
+
+// CompareMonitorMaps는 before에서 after로 바뀌면서 생긴 변화를 반환한다.
+// before가 없으면 비교할 대상이 없으므로 변화도 없다.
+func CompareMonitorMaps(before, after *MonitorMap) []*MonitorMapChange {
+	if before == nil || after == nil {
+		return nil
+	}
+
+	var ret []*MonitorMapChange
+
+	if before.epoch != after.epoch {
+		ret = append(ret, &MonitorMapChange{
+			kind:    EventKindMonmapEpochChanged,
+			message: fmt.Sprintf("monmap epoch changed from %d to %d", before.epoch, after.epoch),
+		})
+	}
+
+	for _, monitor := range after.monitors {
+		previous := before.Monitor(monitor.name)
+		if previous == nil {
+			continue
+		}
+
+		switch {
+		case previous.inQuorum && !monitor.inQuorum:
+			ret = append(ret, &MonitorMapChange{
+				kind:    EventKindMonitorLeftQuorum,
+				message: fmt.Sprintf("monitor %s left quorum", monitor.name),
+			})
+		case !previous.inQuorum && monitor.inQuorum:
+			ret = append(ret, &MonitorMapChange{
+				kind:    EventKindMonitorJoinedQuorum,
+				message: fmt.Sprintf("monitor %s joined quorum", monitor.name),
+			})
+		}
+	}
+
+	return ret
+}
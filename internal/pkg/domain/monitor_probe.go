@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// MonitorProbe는 cepher에서 monitor 주소로 직접 접속해 본 결과이다.
+type MonitorProbe struct {
+	address    *Address
+	reachable  bool
+	latency    time.Duration
+	lastError  string
+	probedTime time.Time
+}
+
+func NewMonitorProbe(
+	address *Address,
+	reachable bool,
+	latency time.Duration,
+	lastError string,
+	probedTime time.Time,
+) (*MonitorProbe, error) {
+	if address == nil {
+		return nil, InvalidParameterError("address")
+	}
+
+	if latency < 0 {
+		return nil, InvalidParameterError("latency")
+	}
+
+	if reachable && lastError != "" {
+		return nil, InvalidParameterError("lastError")
+	}
+
+	if probedTime.IsZero() {
+		return nil, InvalidParameterError("probedTime")
+	}
+
+	return &MonitorProbe{
+		address:    address,
+		reachable:  reachable,
+		latency:    latency,
+		lastError:  lastError,
+		probedTime: probedTime,
+	}, nil
+}
+
+func (p *MonitorProbe) Address() *Address {
+	return p.address
+}
+
+func (p *MonitorProbe) Reachable() bool {
+	return p.reachable
+}
+
+// Latency는 접속하고 messenger banner를 받기까지 걸린 시간이다.
+func (p *MonitorProbe) Latency() time.Duration {
+	return p.latency
+}
+
+func (p *MonitorProbe) LastError() string {
+	return p.lastError
+}
+
+func (p *MonitorProbe) ProbedTime() time.Time {
+	return p.probedTime
+}
+
+// sameReachability는 같은 주소의 접속 여부가 같은지 비교한다.
+func (p *MonitorProbe) sameReachability(other *MonitorProbe) bool {
+	return p.address.equal(other.address) && p.reachable == other.reachable
+}
+
+// prioritizeHosts는 접속이 확인된 host를 latency 순으로 앞에 두고, 확인하지 못한 host를 그 뒤에 둔다.
+// 접속에 실패한 host는 다른 host가 하나도 없을 때만 포함한다.
+func prioritizeHosts(hosts []*Address, probes []*MonitorProbe) []*Address {
+	results := make(map[string]*MonitorProbe)
+	for _, probe := range probes {
+		results[probe.address.String()] = probe
+	}
+
+	var reachable, unknown, unreachable []*Address
+
+	for _, host := range hosts {
+		probe, ok := results[host.String()]
+
+		switch {
+		case !ok:
+			unknown = append(unknown, host)
+		case probe.reachable:
+			reachable = append(reachable, host)
+		default:
+			unreachable = append(unreachable, host)
+		}
+	}
+
+	slices.SortStableFunc(reachable, func(a, b *Address) int {
+		return cmp.Compare(results[a.String()].latency, results[b.String()].latency)
+	})
+
+	ret := slices.Concat(reachable, unknown)
+	if len(ret) == 0 {
+		ret = unreachable
+	}
+
+	return ret
+}
@@ -0,0 +1,117 @@
+package domain
+
+type OSD struct {
+	id          int
+	name        string
+	host        string
+	deviceClass string
+	up          bool
+	in          bool
+	crushWeight float64
+	reweight    float64
+	utilization float64
+	pgs         int
+}
+
+func NewOSD(
+	id int,
+	name string,
+	host string,
+	deviceClass string,
+	up bool,
+	in bool,
+	crushWeight float64,
+	reweight float64,
+	utilization float64,
+	pgs int,
+) (*OSD, error) {
+	ret := OSD{
+		id:          id,
+		name:        name,
+		host:        host,
+		deviceClass: deviceClass,
+		up:          up,
+		in:          in,
+		crushWeight: crushWeight,
+		reweight:    reweight,
+		utilization: utilization,
+		pgs:         pgs,
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (o *OSD) ID() int {
+	return o.id
+}
+
+func (o *OSD) Name() string {
+	return o.name
+}
+
+// Host는 crush map 상에서 OSD가 속한 host이다. host에 속하지 않으면 빈 문자열이다.
+func (o *OSD) Host() string {
+	return o.host
+}
+
+func (o *OSD) DeviceClass() string {
+	return o.deviceClass
+}
+
+func (o *OSD) IsUp() bool {
+	return o.up
+}
+
+func (o *OSD) IsIn() bool {
+	return o.in
+}
+
+func (o *OSD) CrushWeight() float64 {
+	return o.crushWeight
+}
+
+func (o *OSD) Reweight() float64 {
+	return o.reweight
+}
+
+// Utilization은 사용률(%)이다.
+func (o *OSD) Utilization() float64 {
+	return o.utilization
+}
+
+func (o *OSD) PGs() int {
+	return o.pgs
+}
+
+func (o *OSD) validate() error {
+	if o.id < 0 {
+		return InvalidParameterError("id")
+	}
+
+	if o.name == "" {
+		return InvalidParameterError("name")
+	}
+
+	if o.crushWeight < 0 {
+		return InvalidParameterError("crushWeight")
+	}
+
+	if o.reweight < 0 || o.reweight > 1 {
+		return InvalidParameterError("reweight")
+	}
+
+	if o.utilization < 0 {
+		return InvalidParameterError("utilization")
+	}
+
+	if o.pgs < 0 {
+		return InvalidParameterError("pgs")
+	}
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package domain
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// Overview는 refresh 시점의 cluster 상태를 요약한 snapshot이다.
+type Overview struct {
+	clusterID     string
+	collectedTime time.Time
+	capacity      *Capacity
+	osds          *OSDCount
+	pgs           *PGCount
+	poolCount     int
+	clientIO      *ClientIO
+	quorum        []string
+}
+
+func NewOverview(
+	clusterID string,
+	collectedTime time.Time,
+	capacity *Capacity,
+	osds *OSDCount,
+	pgs *PGCount,
+	poolCount int,
+	clientIO *ClientIO,
+	quorum []string,
+) (*Overview, error) {
+	ret := Overview{
+		clusterID:     clusterID,
+		collectedTime: collectedTime,
+		capacity:      capacity,
+		osds:          osds,
+		pgs:           pgs,
+		poolCount:     poolCount,
+		clientIO:      clientIO,
+		quorum:        slices.Clone(quorum),
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (o *Overview) ClusterID() string {
+	return o.clusterID
+}
+
+func (o *Overview) CollectedTime() time.Time {
+	return o.collectedTime
+}
+
+func (o *Overview) Capacity() *Capacity {
+	return o.capacity
+}
+
+func (o *Overview) OSDs() *OSDCount {
+	return o.osds
+}
+
+func (o *Overview) PGs() *PGCount {
+	return o.pgs
+}
+
+func (o *Overview) PoolCount() int {
+	return o.poolCount
+}
+
+func (o *Overview) ClientIO() *ClientIO {
+	return o.clientIO
+}
+
+func (o *Overview) Quorum() []string {
+	return slices.Clone(o.quorum)
+}
+
+func (o *Overview) validate() error {
+	if o.clusterID == "" {
+		return InvalidParameterError("clusterID")
+	}
+
+	if o.collectedTime.IsZero() {
+		return InvalidParameterError("collectedTime")
+	}
+
+	if o.capacity == nil {
+		return InvalidParameterError("capacity")
+	}
+
+	if o.osds == nil {
+		return InvalidParameterError("osds")
+	}
+
+	if o.pgs == nil {
+		return InvalidParameterError("pgs")
+	}
+
+	if o.poolCount < 0 {
+		return InvalidParameterError("poolCount")
+	}
+
+	if o.clientIO == nil {
+		return InvalidParameterError("clientIO")
+	}
+
+	return nil
+}
+
+type Capacity struct {
+	totalBytes int64
+	usedBytes  int64
+	availBytes int64
+}
+
+func NewCapacity(totalBytes, usedBytes, availBytes int64) (*Capacity, error) {
+	if totalBytes < 0 {
+		return nil, InvalidParameterError("totalBytes")
+	}
+
+	if usedBytes < 0 {
+		return nil, InvalidParameterError("usedBytes")
+	}
+
+	if availBytes < 0 {
+		return nil, InvalidParameterError("availBytes")
+	}
+
+	return &Capacity{
+		totalBytes: totalBytes,
+		usedBytes:  usedBytes,
+		availBytes: availBytes,
+	}, nil
+}
+
+func (c *Capacity) TotalBytes() int64 {
+	return c.totalBytes
+}
+
+func (c *Capacity) UsedBytes() int64 {
+	return c.usedBytes
+}
+
+func (c *Capacity) AvailBytes() int64 {
+	return c.availBytes
+}
+
+type OSDCount struct {
+	total int
+	up    int
+	in    int
+}
+
+func NewOSDCount(total, up, in int) (*OSDCount, error) {
+	if total < 0 {
+		return nil, InvalidParameterError("total")
+	}
+
+	if up < 0 || up > total {
+		return nil, InvalidParameterError("up")
+	}
+
+	if in < 0 || in > total {
+		return nil, InvalidParameterError("in")
+	}
+
+	return &OSDCount{
+		total: total,
+		up:    up,
+		in:    in,
+	}, nil
+}
+
+func (c *OSDCount) Total() int {
+	return c.total
+}
+
+func (c *OSDCount) Up() int {
+	return c.up
+}
+
+func (c *OSDCount) In() int {
+	return c.in
+}
+
+type PGCount struct {
+	total  int
+	states map[string]int
+}
+
+func NewPGCount(total int, states map[string]int) (*PGCount, error) {
+	if total < 0 {
+		return nil, InvalidParameterError("total")
+	}
+
+	for _, count := range states {
+		if count < 0 {
+			return nil, InvalidParameterError("states")
+		}
+	}
+
+	return &PGCount{
+		total:  total,
+		states: maps.Clone(states),
+	}, nil
+}
+
+func (c *PGCount) Total() int {
+	return c.total
+}
+
+func (c *PGCount) States() map[string]int {
+	return maps.Clone(c.states)
+}
+
+type ClientIO struct {
+	readBytesPerSec  int64
+	writeBytesPerSec int64
+	readOpsPerSec    int64
+	writeOpsPerSec   int64
+}
+
+func NewClientIO(readBytesPerSec, writeBytesPerSec, readOpsPerSec, writeOpsPerSec int64) *ClientIO {
+	return &ClientIO{
+		readBytesPerSec:  readBytesPerSec,
+		writeBytesPerSec: writeBytesPerSec,
+		readOpsPerSec:    readOpsPerSec,
+		writeOpsPerSec:   writeOpsPerSec,
+	}
+}
+
+func (c *ClientIO) ReadBytesPerSec() int64 {
+	return c.readBytesPerSec
+}
+
+func (c *ClientIO) WriteBytesPerSec() int64 {
+	return c.writeBytesPerSec
+}
+
+func (c *ClientIO) ReadOpsPerSec() int64 {
+	return c.readOpsPerSec
+}
+
+func (c *ClientIO) WriteOpsPerSec() int64 {
+	return c.writeOpsPerSec
+}
@@ -0,0 +1,31 @@
+package domain
+
+type Reachability string
+
+const (
+	ReachabilityUnknown      Reachability = "UNKNOWN"
+	ReachabilityReachable    Reachability = "REACHABLE"
+	ReachabilityUnreachable  Reachability = "UNREACHABLE"
+	ReachabilityAuthFailure  Reachability = "AUTH_FAILURE"
+	ReachabilityTimeout      Reachability = "TIMEOUT"
+	ReachabilityQuorumLost   Reachability = "QUORUM_LOST"
+	ReachabilityRuntimeError Reachability = "RUNTIME_ERROR"
+	// ReachabilityIdentityMismatch는 접근한 cluster의 fsid가 등록된 fsid와 다른 상태이다.
+	ReachabilityIdentityMismatch Reachability = "IDENTITY_MISMATCH"
+)
+
+func (r Reachability) validate() error {
+	switch r {
+	case ReachabilityUnknown,
+		ReachabilityReachable,
+		ReachabilityUnreachable,
+		ReachabilityAuthFailure,
+		ReachabilityTimeout,
+		ReachabilityQuorumLost,
+		ReachabilityRuntimeError,
+		ReachabilityIdentityMismatch:
+		return nil
+	default:
+		return InvalidParameterError("reachability")
+	}
+}
@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Release는 ceph release의 이름이다. 예: "reef", "squid"
+// 알지 못하는 release도 그대로 담을 수 있고, 확인하지 못했으면 빈 문자열이다.
+type Release string
+
+const (
+	ReleaseUnknown  Release = ""
+	ReleaseQuincy   Release = "quincy"
+	ReleaseReef     Release = "reef"
+	ReleaseSquid    Release = "squid"
+	ReleaseTentacle Release = "tentacle"
+)
+
+var releaseMajors = map[Release]int{ //nolint:gochecknoglobals
+	ReleaseQuincy:   17, //nolint:mnd
+	ReleaseReef:     18, //nolint:mnd
+	ReleaseSquid:    19, //nolint:mnd
+	ReleaseTentacle: 20, //nolint:mnd
+}
+
+// Major는 release의 major 버전이다. 알지 못하는 release면 0이다.
+func (r Release) Major() int {
+	return releaseMajors[r]
+}
+
+// DaemonVersion은 같은 버전으로 동작하는 한 종류의 daemon 수이다.
+type DaemonVersion struct {
+	daemon  string
+	version string
+	release Release
+	count   int
+}
+
+func NewDaemonVersion(daemon, version string, release Release, count int) (*DaemonVersion, error) {
+	if daemon == "" {
+		return nil, InvalidParameterError("daemon")
+	}
+
+	if version == "" {
+		return nil, InvalidParameterError("version")
+	}
+
+	if count <= 0 {
+		return nil, InvalidParameterError("count")
+	}
+
+	return &DaemonVersion{
+		daemon:  daemon,
+		version: version,
+		release: release,
+		count:   count,
+	}, nil
+}
+
+// ParseDaemonVersion은 `ceph versions`의 항목을 해석한다.
+// 예: "ceph version 18.2.1 (7fe91d5d5842e04be3b4f514d6dd990c54b29c76) reef (stable)"
+func ParseDaemonVersion(daemon, description string, count int) (*DaemonVersion, error) {
+	fields := strings.Fields(description)
+
+	const minFields = 3
+	if len(fields) < minFields || fields[0] != "ceph" || fields[1] != "version" {
+		return nil, InvalidParameterError("description")
+	}
+
+	version := fields[2]
+
+	var release Release
+
+	// release 이름은 commit hash 뒤, "(stable)" 같은 표시 앞에 있다.
+	for i, field := range fields {
+		if strings.HasSuffix(field, ")") && strings.HasPrefix(field, "(") && i > 2 && i+1 < len(fields) {
+			release = Release(fields[i+1])
+
+			break
+		}
+	}
+
+	if release == ReleaseUnknown {
+		release = releaseOfVersion(version)
+	}
+
+	return NewDaemonVersion(daemon, version, release, count)
+}
+
+func releaseOfVersion(version string) Release {
+	major, _, _ := strings.Cut(version, ".")
+
+	number, err := strconv.Atoi(major)
+	if err != nil {
+		return ReleaseUnknown
+	}
+
+	for release, releaseMajor := range releaseMajors {
+		if releaseMajor == number {
+			return release
+		}
+	}
+
+	return ReleaseUnknown
+}
+
+func (v *DaemonVersion) Daemon() string {
+	return v.daemon
+}
+
+func (v *DaemonVersion) Version() string {
+	return v.version
+}
+
+func (v *DaemonVersion) Release() Release {
+	return v.release
+}
+
+func (v *DaemonVersion) Count() int {
+	return v.count
+}
+
+// Versions는 cluster의 release와 daemon 종류별 버전이다.
+type Versions struct {
+	release Release
+	daemons []*DaemonVersion
+}
+
+// NewVersions는 release가 비어 있으면 가장 오래된 monitor의 release를 cluster의 release로 본다.
+func NewVersions(release Release, daemons []*DaemonVersion) (*Versions, error) {
+	for _, daemon := range daemons {
+		if daemon == nil {
+			return nil, InvalidParameterError("daemons")
+		}
+	}
+
+	if release == ReleaseUnknown {
+		release = oldestMonitorRelease(daemons)
+	}
+
+	return &Versions{
+		release: release,
+		daemons: slices.Clone(daemons),
+	}, nil
+}
+
+func oldestMonitorRelease(daemons []*DaemonVersion) Release {
+	ret := ReleaseUnknown
+
+	for _, daemon := range daemons {
+		if daemon.daemon != "mon" || daemon.release == ReleaseUnknown {
+			continue
+		}
+
+		if ret == ReleaseUnknown || daemon.release.Major() < ret.Major() {
+			ret = daemon.release
+		}
+	}
+
+	return ret
+}
+
+func (v *Versions) Release() Release {
+	return v.release
+}
+
+func (v *Versions) Daemons() []*DaemonVersion {
+	return slices.Clone(v.daemons)
+}
+
+// IsMixed는 daemon들이 서로 다른 버전으로 동작하는지 확인한다. upgrade 중이면 true이다.
+func (v *Versions) IsMixed() bool {
+	var version string
+
+	for _, daemon := range v.daemons {
+		if version != "" && version != daemon.version {
+			return true
+		}
+
+		version = daemon.version
+	}
+
+	return false
+}
+
+func (v *Versions) equal(other *Versions) bool {
+	return reflect.DeepEqual(v, other)
+}
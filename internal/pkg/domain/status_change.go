@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"time"
+)
+
+// StatusChange는 cluster의 status가 바뀐 기록이다. 가용성을 계산하는 데 쓴다.
+// from과 to가 같으면 기록이 없던 cluster의 그 시점 status를 남긴 baseline이다.
+type StatusChange struct {
+	clusterID string
+	from      ClusterStatus
+	to        ClusterStatus
+	time      time.Time
+}
+
+func NewStatusChange(clusterID string, from, to ClusterStatus, time time.Time) (*StatusChange, error) {
+	ret := StatusChange{
+		clusterID: clusterID,
+		from:      from,
+		to:        to,
+		time:      time,
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (c *StatusChange) ClusterID() string {
+	return c.clusterID
+}
+
+func (c *StatusChange) From() ClusterStatus {
+	return c.from
+}
+
+func (c *StatusChange) To() ClusterStatus {
+	return c.to
+}
+
+func (c *StatusChange) Time() time.Time {
+	return c.time
+}
+
+func (c *StatusChange) validate() error {
+	if c.clusterID == "" {
+		return InvalidParameterError("clusterID")
+	}
+
+	err := c.from.validate()
+	if err != nil {
+		return InvalidParameterError("from")
+	}
+
+	err = c.to.validate()
+	if err != nil {
+		return InvalidParameterError("to")
+	}
+
+	if c.time.IsZero() {
+		return InvalidParameterError("time")
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// UsageSample은 한 시점에 수집한 cluster와 pool의 사용량이다.
+type UsageSample struct {
+	clusterID     string
+	collectedTime time.Time
+	totalBytes    int64
+	usedBytes     int64
+	usedRatio     float64
+	pools         []*PoolUsage
+}
+
+func NewUsageSample(
+	clusterID string,
+	collectedTime time.Time,
+	totalBytes int64,
+	usedBytes int64,
+	usedRatio float64,
+	pools []*PoolUsage,
+) (*UsageSample, error) {
+	ret := UsageSample{
+		clusterID:     clusterID,
+		collectedTime: collectedTime,
+		totalBytes:    totalBytes,
+		usedBytes:     usedBytes,
+		usedRatio:     usedRatio,
+		pools:         slices.Clone(pools),
+	}
+
+	err := ret.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ret, nil
+}
+
+func (s *UsageSample) ClusterID() string {
+	return s.clusterID
+}
+
+func (s *UsageSample) CollectedTime() time.Time {
+	return s.collectedTime
+}
+
+func (s *UsageSample) TotalBytes() int64 {
+	return s.totalBytes
+}
+
+// UsedBytes는 raw 사용량이다.
+func (s *UsageSample) UsedBytes() int64 {
+	return s.usedBytes
+}
+
+func (s *UsageSample) UsedRatio() float64 {
+	return s.usedRatio
+}
+
+func (s *UsageSample) Pools() []*PoolUsage {
+	return slices.Clone(s.pools)
+}
+
+func (s *UsageSample) Pool(name string) *PoolUsage {
+	for _, pool := range s.pools {
+		if pool.name == name {
+			return pool
+		}
+	}
+
+	return nil
+}
+
+func (s *UsageSample) validate() error {
+	if s.clusterID == "" {
+		return InvalidParameterError("clusterID")
+	}
+
+	if s.collectedTime.IsZero() {
+		return InvalidParameterError("collectedTime")
+	}
+
+	if s.totalBytes < 0 {
+		return InvalidParameterError("totalBytes")
+	}
+
+	if s.usedBytes < 0 {
+		return InvalidParameterError("usedBytes")
+	}
+
+	if s.usedRatio < 0 {
+		return InvalidParameterError("usedRatio")
+	}
+
+	for _, pool := range s.pools {
+		if pool == nil {
+			return InvalidParameterError("pools")
+		}
+	}
+
+	return nil
+}
+
+type PoolUsage struct {
+	name          string
+	storedBytes   int64
+	usedBytes     int64
+	maxAvailBytes int64
+	usedRatio     float64
+}
+
+func NewPoolUsage(name string, storedBytes, usedBytes, maxAvailBytes int64, usedRatio float64) (*PoolUsage, error) {
+	if name == "" {
+		return nil, InvalidParameterError("name")
+	}
+
+	if storedBytes < 0 {
+		return nil, InvalidParameterError("storedBytes")
+	}
+
+	if usedBytes < 0 {
+		return nil, InvalidParameterError("usedBytes")
+	}
+
+	if maxAvailBytes < 0 {
+		return nil, InvalidParameterError("maxAvailBytes")
+	}
+
+	if usedRatio < 0 {
+		return nil, InvalidParameterError("usedRatio")
+	}
+
+	return &PoolUsage{
+		name:          name,
+		storedBytes:   storedBytes,
+		usedBytes:     usedBytes,
+		maxAvailBytes: maxAvailBytes,
+		usedRatio:     usedRatio,
+	}, nil
+}
+
+func (p *PoolUsage) Name() string {
+	return p.name
+}
+
+func (p *PoolUsage) StoredBytes() int64 {
+	return p.storedBytes
+}
+
+func (p *PoolUsage) UsedBytes() int64 {
+	return p.usedBytes
+}
+
+func (p *PoolUsage) MaxAvailBytes() int64 {
+	return p.maxAvailBytes
+}
+
+func (p *PoolUsage) UsedRatio() float64 {
+	return p.usedRatio
+}
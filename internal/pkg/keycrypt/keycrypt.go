@@ -0,0 +1,116 @@
+// Package keycrypt는 export 문서에 담는 ceph key를 passphrase로 암호화한다.
+// passphrase에서 PBKDF2-SHA256으로 AES-256 key를 만들고, key마다 새 nonce로 AES-GCM 암호화한다.
+package keycrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const (
+	Algorithm = "AES-256-GCM"
+	KDF       = "PBKDF2-SHA256"
+	// DefaultIterations는 OWASP가 PBKDF2-SHA256에 권장하는 반복 횟수이다.
+	DefaultIterations = 600_000
+
+	keyLength  = 32
+	saltLength = 16
+)
+
+var (
+	ErrEmptyPassphrase = errors.New("empty passphrase")
+	ErrInvalidParams   = errors.New("invalid key derivation parameters")
+	ErrDecryptFailed   = errors.New("failed to decrypt key")
+)
+
+// Params는 passphrase에서 암호화 key를 만들 때 쓴 값이다. 복호화하려면 같은 값이 필요하다.
+type Params struct {
+	Iterations int
+	Salt       []byte
+}
+
+// NewParams는 기본 반복 횟수와 무작위 salt로 Params를 만든다.
+func NewParams() (*Params, error) {
+	salt := make([]byte, saltLength)
+
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	return &Params{
+		Iterations: DefaultIterations,
+		Salt:       salt,
+	}, nil
+}
+
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+func New(passphrase string, params *Params) (*Cipher, error) {
+	if passphrase == "" {
+		return nil, ErrEmptyPassphrase
+	}
+
+	if params == nil || params.Iterations <= 0 || len(params.Salt) == 0 {
+		return nil, ErrInvalidParams
+	}
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, params.Salt, params.Iterations, keyLength)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal은 plaintext를 암호화해서 base64(nonce || ciphertext)로 반환한다.
+func (c *Cipher) Seal(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open은 Seal로 암호화한 값을 복호화한다. passphrase가 다르거나 값이 바뀌었으면 ErrDecryptFailed를 반환한다.
+func (c *Cipher) Open(sealed string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrDecryptFailed, err)
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(data) < nonceSize {
+		return "", ErrDecryptFailed
+	}
+
+	plaintext, err := c.aead.Open(nil, data[:nonceSize], data[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrDecryptFailed, err)
+	}
+
+	return string(plaintext), nil
+}
@@ -0,0 +1,183 @@
+package keycrypt_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/neatflowcv/cepher/internal/pkg/keycrypt"
+)
+
+// testIterations는 test가 빨리 끝나도록 DefaultIterations 대신 쓰는 반복 횟수이다.
+const testIterations = 1000
+
+func newCipher(t *testing.T, passphrase string, salt string) *keycrypt.Cipher {
+	t.Helper()
+
+	ret, err := keycrypt.New(passphrase, &keycrypt.Params{Iterations: testIterations, Salt: []byte(salt)})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	return ret
+}
+
+func TestCipher_SealOpen(t *testing.T) {
+	t.Parallel()
+
+	sealer := newCipher(t, "correct horse", "0123456789abcdef")
+
+	tests := []struct {
+		name      string
+		plaintext string
+	}{
+		{name: "ceph key", plaintext: "AQBzwH5mAAAAABAAr7Ahd6J0pUXBbnLB4WMj2A=="},
+		{name: "empty", plaintext: ""},
+		{name: "multibyte", plaintext: "비밀 key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sealed, err := sealer.Seal(tt.plaintext)
+			if err != nil {
+				t.Fatalf("Seal() error = %v", err)
+			}
+
+			opened, err := newCipher(t, "correct horse", "0123456789abcdef").Open(sealed)
+			if err != nil {
+				t.Fatalf("Open() error = %v", err)
+			}
+
+			if opened != tt.plaintext {
+				t.Errorf("Open() = %q, want %q", opened, tt.plaintext)
+			}
+		})
+	}
+}
+
+func TestCipher_Seal_UsesNewNonce(t *testing.T) {
+	t.Parallel()
+
+	sealer := newCipher(t, "correct horse", "0123456789abcdef")
+
+	first, err := sealer.Seal("key")
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	second, err := sealer.Seal("key")
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	if first == second {
+		t.Errorf("Seal() returned the same value twice: %q", first)
+	}
+}
+
+func TestCipher_Open_Fails(t *testing.T) {
+	t.Parallel()
+
+	sealed, err := newCipher(t, "correct horse", "0123456789abcdef").Seal("AQBzwH5mAAAAABAAr7Ahd6J0pUXBbnLB4WMj2A==")
+	if err != nil {
+		t.Fatalf("Seal() error = %v", err)
+	}
+
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		t.Fatalf("failed to decode sealed value: %v", err)
+	}
+
+	data[len(data)-1] ^= 0xff
+	tampered := base64.StdEncoding.EncodeToString(data)
+
+	tests := []struct {
+		name       string
+		passphrase string
+		salt       string
+		sealed     string
+	}{
+		{name: "wrong passphrase", passphrase: "battery staple", salt: "0123456789abcdef", sealed: sealed},
+		{name: "wrong salt", passphrase: "correct horse", salt: "fedcba9876543210", sealed: sealed},
+		{name: "tampered", passphrase: "correct horse", salt: "0123456789abcdef", sealed: tampered},
+		{name: "not base64", passphrase: "correct horse", salt: "0123456789abcdef", sealed: "not base64!"},
+		{name: "shorter than nonce", passphrase: "correct horse", salt: "0123456789abcdef", sealed: "AAAA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newCipher(t, tt.passphrase, tt.salt).Open(tt.sealed)
+			if !errors.Is(err, keycrypt.ErrDecryptFailed) {
+				t.Errorf("Open() error = %v, want %v", err, keycrypt.ErrDecryptFailed)
+			}
+		})
+	}
+}
+
+func TestNew_InvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		passphrase string
+		params     *keycrypt.Params
+		want       error
+	}{
+		{
+			name:       "empty passphrase",
+			passphrase: "",
+			params:     &keycrypt.Params{Iterations: testIterations, Salt: []byte("salt")},
+			want:       keycrypt.ErrEmptyPassphrase,
+		},
+		{name: "no params", passphrase: "correct horse", params: nil, want: keycrypt.ErrInvalidParams},
+		{
+			name:       "no iterations",
+			passphrase: "correct horse",
+			params:     &keycrypt.Params{Iterations: 0, Salt: []byte("salt")},
+			want:       keycrypt.ErrInvalidParams,
+		},
+		{
+			name:       "no salt",
+			passphrase: "correct horse",
+			params:     &keycrypt.Params{Iterations: testIterations, Salt: nil},
+			want:       keycrypt.ErrInvalidParams,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := keycrypt.New(tt.passphrase, tt.params)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("New() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	t.Parallel()
+
+	first, err := keycrypt.NewParams()
+	if err != nil {
+		t.Fatalf("NewParams() error = %v", err)
+	}
+
+	second, err := keycrypt.NewParams()
+	if err != nil {
+		t.Fatalf("NewParams() error = %v", err)
+	}
+
+	if first.Iterations != keycrypt.DefaultIterations {
+		t.Errorf("iterations = %d, want %d", first.Iterations, keycrypt.DefaultIterations)
+	}
+
+	if string(first.Salt) == string(second.Salt) {
+		t.Errorf("NewParams() returned the same salt twice")
+	}
+}
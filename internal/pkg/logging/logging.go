@@ -0,0 +1,82 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrUnknownFormat = errors.New("unknown log format")
+
+// New는 format("text" 또는 "json")과 level("debug", "info", "warn", "error")에 맞는 logger를 만든다.
+// context에 WithAttrs로 남긴 속성은 *Context 메서드로 기록할 때 함께 남는다.
+func New(writer io.Writer, format string, level string) (*slog.Logger, error) {
+	var slogLevel slog.Level
+
+	if level != "" {
+		err := slogLevel.UnmarshalText([]byte(level))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse log level: %w", err)
+		}
+	}
+
+	options := &slog.HandlerOptions{Level: slogLevel} //nolint:exhaustruct
+
+	var handler slog.Handler
+
+	switch strings.ToLower(format) {
+	case "", "text":
+		handler = slog.NewTextHandler(writer, options)
+	case "json":
+		handler = slog.NewJSONHandler(writer, options)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+	}
+
+	return slog.New(&contextHandler{Handler: handler}), nil
+}
+
+type attrsKey struct{}
+
+// WithAttrs는 ctx로 남기는 모든 log에 attrs를 붙인다. request id, cluster id 같은 상관 관계 정보에 쓴다.
+func WithAttrs(ctx context.Context, attrs ...slog.Attr) context.Context {
+	existing, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+
+	merged := make([]slog.Attr, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+
+	return context.WithValue(ctx, attrsKey{}, merged)
+}
+
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	attrs, ok := ctx.Value(attrsKey{}).([]slog.Attr)
+	if ok {
+		record.AddAttrs(attrs...)
+	}
+
+	// tracing을 켰다면 log와 trace를 이어 볼 수 있도록 trace id를 남긴다.
+	spanContext := trace.SpanContextFromContext(ctx)
+	if spanContext.IsValid() {
+		record.AddAttrs(slog.String("trace_id", spanContext.TraceID().String()))
+	}
+
+	return h.Handler.Handle(ctx, record) //nolint:wrapcheck
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,12 @@
+package prober
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type Prober interface {
+	Probe(ctx context.Context, addresses []*domain.Address, now time.Time) ([]*domain.MonitorProbe, error)
+}
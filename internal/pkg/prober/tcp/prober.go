@@ -0,0 +1,122 @@
+package tcp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/prober"
+)
+
+var _ prober.Prober = (*Prober)(nil)
+
+var ErrNotMonitor = errors.New("not a ceph monitor")
+
+// msgr v1은 "ceph v027", msgr v2는 "ceph v2\n"으로 시작하는 banner를 먼저 보낸다.
+var bannerPrefix = []byte("ceph v")
+
+// Prober는 monitor 주소에 TCP로 접속해 messenger banner를 받을 수 있는지 확인한다.
+type Prober struct {
+	timeout time.Duration
+	logger  *slog.Logger
+}
+
+func NewProber(logger *slog.Logger) *Prober {
+	const timeout = 3 * time.Second
+
+	return &Prober{
+		timeout: timeout,
+		logger:  logger,
+	}
+}
+
+func (p *Prober) Probe(
+	ctx context.Context,
+	addresses []*domain.Address,
+	now time.Time,
+) ([]*domain.MonitorProbe, error) {
+	var (
+		wg   sync.WaitGroup
+		ret  = make([]*domain.MonitorProbe, len(addresses))
+		errs = make([]error, len(addresses))
+	)
+
+	for i, address := range addresses {
+		wg.Go(func() {
+			ret[i], errs[i] = p.probe(ctx, address, now)
+		})
+	}
+
+	wg.Wait()
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (p *Prober) probe(ctx context.Context, address *domain.Address, now time.Time) (*domain.MonitorProbe, error) {
+	start := time.Now()
+
+	err := p.handshake(ctx, address)
+
+	latency := time.Since(start)
+	if err != nil {
+		probe, newErr := domain.NewMonitorProbe(address, false, latency, err.Error(), now)
+		if newErr != nil {
+			return nil, fmt.Errorf("failed to create domain monitor probe: %w", newErr)
+		}
+
+		return probe, nil
+	}
+
+	probe, err := domain.NewMonitorProbe(address, true, latency, "", now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain monitor probe: %w", err)
+	}
+
+	return probe, nil
+}
+
+func (p *Prober) handshake(ctx context.Context, address *domain.Address) error {
+	dialer := &net.Dialer{Timeout: p.timeout} //nolint:exhaustruct
+
+	conn, err := dialer.DialContext(ctx, "tcp", address.HostPort())
+	if err != nil {
+		return fmt.Errorf("failed to dial: %w", err)
+	}
+
+	defer func() {
+		err := conn.Close()
+		if err != nil {
+			p.logger.WarnContext(ctx, "failed to close connection", "address", address.HostPort(), "error", err)
+		}
+	}()
+
+	err = conn.SetReadDeadline(time.Now().Add(p.timeout))
+	if err != nil {
+		return fmt.Errorf("failed to set deadline: %w", err)
+	}
+
+	banner := make([]byte, len(bannerPrefix))
+
+	_, err = io.ReadFull(conn, banner)
+	if err != nil {
+		return fmt.Errorf("failed to read banner: %w", err)
+	}
+
+	if !bytes.Equal(banner, bannerPrefix) {
+		return fmt.Errorf("%w: unexpected banner %q", ErrNotMonitor, banner)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+// AuditFilter는 audit log 조회 조건이다. 비어 있는 조건은 적용하지 않는다.
+type AuditFilter struct {
+	ClusterID string
+	Actor     string
+	Action    domain.AuditAction
+	Since     time.Time
+	Until     time.Time
+}
+
+// Match는 entry가 조건을 모두 만족하는지 확인한다.
+func (f *AuditFilter) Match(entry *domain.AuditEntry) bool {
+	switch {
+	case f.ClusterID != "" && entry.ClusterID() != f.ClusterID,
+		f.Actor != "" && entry.Actor() != f.Actor,
+		f.Action != "" && entry.Action() != f.Action,
+		!f.Since.IsZero() && entry.Time().Before(f.Since),
+		!f.Until.IsZero() && !entry.Time().Before(f.Until):
+		return false
+	default:
+		return true
+	}
+}
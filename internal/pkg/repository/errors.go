@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrClusterAlreadyExists = errors.New("cluster already exists")
 	ErrClusterNotFound      = errors.New("cluster not found")
+	ErrOverviewNotFound     = errors.New("overview not found")
+	ErrJobNotFound          = errors.New("job not found")
 )
@@ -0,0 +1,67 @@
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type AuditEntry struct {
+	ID        string
+	Time      time.Time
+	Actor     string
+	ClusterID string
+	Action    string
+	Detail    string
+	Changes   []*FieldChange
+}
+
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+func NewAuditEntry(entry *domain.AuditEntry) *AuditEntry {
+	var changes []*FieldChange
+	for _, change := range entry.Changes() {
+		changes = append(changes, &FieldChange{
+			Field:  change.Field(),
+			Before: change.Before(),
+			After:  change.After(),
+		})
+	}
+
+	return &AuditEntry{
+		ID:        entry.ID(),
+		Time:      entry.Time(),
+		Actor:     entry.Actor(),
+		ClusterID: entry.ClusterID(),
+		Action:    string(entry.Action()),
+		Detail:    entry.Detail(),
+		Changes:   changes,
+	}
+}
+
+func (e *AuditEntry) ToDomain() (*domain.AuditEntry, error) {
+	var changes []*domain.FieldChange
+
+	for _, change := range e.Changes {
+		dChange, err := domain.NewFieldChange(change.Field, change.Before, change.After)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain field change: %w", err)
+		}
+
+		changes = append(changes, dChange)
+	}
+
+	entry, err := domain.NewAuditEntry(
+		e.ID, e.Time, e.Actor, e.ClusterID, domain.AuditAction(e.Action), e.Detail, changes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain audit entry: %w", err)
+	}
+
+	return entry, nil
+}
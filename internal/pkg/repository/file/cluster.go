@@ -8,13 +8,24 @@ import (
 )
 
 type Cluster struct {
-	ID          string
-	Name        string
-	Hosts       []string
-	Key         string
-	Status      string
-	LastBadTime time.Time
-	Detail      any
+	ID              string
+	Name            string
+	Fsid            string
+	Hosts           []string
+	FallbackHosts   []string
+	HostsPinned     bool
+	Entity          string
+	Key             string
+	Labels          map[string]string `json:",omitempty"`
+	Status          string
+	LastBadTime     time.Time
+	Detail          any
+	Reachability    string
+	LastError       string
+	LastContactTime time.Time
+	MonitorMap      *MonitorMap
+	Probes          []*MonitorProbe
+	Versions        *Versions
 }
 
 func NewCluster(cluster *domain.Cluster) *Cluster {
@@ -23,14 +34,30 @@ func NewCluster(cluster *domain.Cluster) *Cluster {
 		hosts = append(hosts, host.String())
 	}
 
+	var fallbackHosts []string
+	for _, host := range cluster.FallbackHosts() {
+		fallbackHosts = append(fallbackHosts, host.String())
+	}
+
 	return &Cluster{
-		ID:          cluster.ID(),
-		Name:        cluster.Name(),
-		Hosts:       hosts,
-		Key:         cluster.Key(),
-		Status:      string(cluster.Status()),
-		LastBadTime: cluster.LastBadTime(),
-		Detail:      cluster.Detail(),
+		ID:              cluster.ID(),
+		Name:            cluster.Name(),
+		Fsid:            cluster.Fsid(),
+		Hosts:           hosts,
+		FallbackHosts:   fallbackHosts,
+		HostsPinned:     cluster.HostsPinned(),
+		Entity:          cluster.Entity(),
+		Key:             cluster.Key(),
+		Labels:          cluster.Labels(),
+		Status:          string(cluster.Status()),
+		LastBadTime:     cluster.LastBadTime(),
+		Detail:          cluster.Detail(),
+		Reachability:    string(cluster.Connection().Reachability()),
+		LastError:       cluster.Connection().LastError(),
+		LastContactTime: cluster.Connection().LastContactTime(),
+		MonitorMap:      NewMonitorMap(cluster.MonitorMap()),
+		Probes:          NewMonitorProbes(cluster.Probes()),
+		Versions:        NewVersions(cluster.Versions()),
 	}
 }
 
@@ -40,8 +67,53 @@ func (c *Cluster) ToDomain() (*domain.Cluster, error) {
 		return nil, fmt.Errorf("failed to create domain addresses: %w", err)
 	}
 
+	fallbackAddresses, err := domain.NewAddressesFromHosts(c.FallbackHosts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain addresses: %w", err)
+	}
+
+	// Reachability가 없는 파일은 이전 버전에서 저장된 것이다.
+	reachability := domain.Reachability(c.Reachability)
+	if reachability == "" {
+		reachability = domain.ReachabilityUnknown
+	}
+
+	connection, err := domain.NewConnection(reachability, c.LastError, c.LastContactTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain connection: %w", err)
+	}
+
+	monitorMap, err := c.MonitorMap.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain monitor map: %w", err)
+	}
+
+	var probes []*domain.MonitorProbe
+
+	for _, probe := range c.Probes {
+		dProbe, err := probe.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain monitor probe: %w", err)
+		}
+
+		probes = append(probes, dProbe)
+	}
+
+	versions, err := c.Versions.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain versions: %w", err)
+	}
+
+	// Entity가 없는 파일은 client.admin만 쓰던 이전 버전에서 저장된 것이다.
+	entity := c.Entity
+	if entity == "" {
+		entity = domain.DefaultEntity
+	}
+
 	cluster, err := domain.NewCluster(
-		c.ID, c.Name, addresses, c.Key, domain.ClusterStatus(c.Status), c.LastBadTime, c.Detail,
+		c.ID, c.Name, c.Fsid, addresses, fallbackAddresses, c.HostsPinned, entity, c.Key, c.Labels,
+		domain.ClusterStatus(c.Status), c.LastBadTime, c.Detail,
+		connection, monitorMap, probes, versions,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create domain cluster: %w", err)
@@ -0,0 +1,35 @@
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type Event struct {
+	ID        string
+	ClusterID string
+	Kind      string
+	Message   string
+	Time      time.Time
+}
+
+func NewEvent(event *domain.Event) *Event {
+	return &Event{
+		ID:        event.ID(),
+		ClusterID: event.ClusterID(),
+		Kind:      string(event.Kind()),
+		Message:   event.Message(),
+		Time:      event.Time(),
+	}
+}
+
+func (e *Event) ToDomain() (*domain.Event, error) {
+	event, err := domain.NewEvent(e.ID, e.ClusterID, domain.EventKind(e.Kind), e.Message, e.Time)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain event: %w", err)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,75 @@
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type Job struct {
+	ID          string
+	Kind        string
+	ClusterID   string
+	Actor       string
+	State       string
+	Steps       []*JobStep
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+type JobStep struct {
+	Name       string
+	State      string
+	Message    string
+	StartTime  time.Time
+	FinishTime time.Time
+}
+
+func NewJob(job *domain.Job) *Job {
+	var steps []*JobStep
+	for _, step := range job.Steps() {
+		steps = append(steps, &JobStep{
+			Name:       step.Name(),
+			State:      string(step.State()),
+			Message:    step.Message(),
+			StartTime:  step.StartTime(),
+			FinishTime: step.FinishTime(),
+		})
+	}
+
+	return &Job{
+		ID:          job.ID(),
+		Kind:        string(job.Kind()),
+		ClusterID:   job.ClusterID(),
+		Actor:       job.Actor(),
+		State:       string(job.State()),
+		Steps:       steps,
+		CreatedTime: job.CreatedTime(),
+		UpdatedTime: job.UpdatedTime(),
+	}
+}
+
+func (j *Job) ToDomain() (*domain.Job, error) {
+	var steps []*domain.JobStep
+
+	for _, step := range j.Steps {
+		dStep, err := domain.NewJobStep(
+			step.Name, domain.JobState(step.State), step.Message, step.StartTime, step.FinishTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain job step: %w", err)
+		}
+
+		steps = append(steps, dStep)
+	}
+
+	job, err := domain.NewJob(
+		j.ID, domain.JobKind(j.Kind), j.ClusterID, j.Actor, domain.JobState(j.State), steps, j.CreatedTime, j.UpdatedTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain job: %w", err)
+	}
+
+	return job, nil
+}
@@ -0,0 +1,56 @@
+package file
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+)
+
+// appendLine은 JSON Lines 파일 끝에 한 줄을 추가한다.
+func (r *Repository) appendLine(path string, line []byte) error {
+	const permission = 0600
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, permission) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			r.logger.Warn("failed to close file", "path", path, "error", err)
+		}
+	}()
+
+	_, err = file.Write(append(line, '\n'))
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+// readLines는 JSON Lines 파일의 빈 줄이 아닌 줄들을 반환한다. 파일이 없으면 빈 결과를 반환한다.
+func readLines(path string) ([][]byte, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var ret [][]byte
+
+	for line := range bytes.SplitSeq(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		ret = append(ret, line)
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,93 @@
+package file
+
+import (
+	"fmt"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type MonitorMap struct {
+	Fsid          string
+	Epoch         int
+	MinMonRelease string
+	Monitors      []*Monitor
+}
+
+type Monitor struct {
+	Name     string
+	Rank     int
+	Addrs    []*MonitorAddress
+	InQuorum bool
+}
+
+type MonitorAddress struct {
+	Protocol string
+	Addr     string
+}
+
+func NewMonitorMap(monitorMap *domain.MonitorMap) *MonitorMap {
+	if monitorMap == nil {
+		return nil
+	}
+
+	var monitors []*Monitor
+
+	for _, monitor := range monitorMap.Monitors() {
+		var addrs []*MonitorAddress
+		for _, addr := range monitor.Addrs() {
+			addrs = append(addrs, &MonitorAddress{
+				Protocol: addr.Protocol(),
+				Addr:     addr.Addr(),
+			})
+		}
+
+		monitors = append(monitors, &Monitor{
+			Name:     monitor.Name(),
+			Rank:     monitor.Rank(),
+			Addrs:    addrs,
+			InQuorum: monitor.InQuorum(),
+		})
+	}
+
+	return &MonitorMap{
+		Fsid:          monitorMap.Fsid(),
+		Epoch:         monitorMap.Epoch(),
+		MinMonRelease: string(monitorMap.MinMonRelease()),
+		Monitors:      monitors,
+	}
+}
+
+func (m *MonitorMap) ToDomain() (*domain.MonitorMap, error) {
+	if m == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	var monitors []*domain.Monitor
+
+	for _, monitor := range m.Monitors {
+		var addrs []*domain.MonitorAddress
+
+		for _, addr := range monitor.Addrs {
+			address, err := domain.NewMonitorAddress(addr.Protocol, addr.Addr)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create domain monitor address: %w", err)
+			}
+
+			addrs = append(addrs, address)
+		}
+
+		dMonitor, err := domain.NewMonitor(monitor.Name, monitor.Rank, addrs, monitor.InQuorum)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain monitor: %w", err)
+		}
+
+		monitors = append(monitors, dMonitor)
+	}
+
+	monitorMap, err := domain.NewMonitorMap(m.Fsid, m.Epoch, domain.Release(m.MinMonRelease), monitors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain monitor map: %w", err)
+	}
+
+	return monitorMap, nil
+}
@@ -0,0 +1,45 @@
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type MonitorProbe struct {
+	Address    string
+	Reachable  bool
+	Latency    time.Duration
+	LastError  string
+	ProbedTime time.Time
+}
+
+func NewMonitorProbes(probes []*domain.MonitorProbe) []*MonitorProbe {
+	var ret []*MonitorProbe
+	for _, probe := range probes {
+		ret = append(ret, &MonitorProbe{
+			Address:    probe.Address().String(),
+			Reachable:  probe.Reachable(),
+			Latency:    probe.Latency(),
+			LastError:  probe.LastError(),
+			ProbedTime: probe.ProbedTime(),
+		})
+	}
+
+	return ret
+}
+
+func (p *MonitorProbe) ToDomain() (*domain.MonitorProbe, error) {
+	address, err := domain.NewAddressFromHost(p.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain address: %w", err)
+	}
+
+	probe, err := domain.NewMonitorProbe(address, p.Reachable, p.Latency, p.LastError, p.ProbedTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain monitor probe: %w", err)
+	}
+
+	return probe, nil
+}
@@ -0,0 +1,76 @@
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type Overview struct {
+	ClusterID        string
+	CollectedTime    time.Time
+	TotalBytes       int64
+	UsedBytes        int64
+	AvailBytes       int64
+	OSDs             int
+	UpOSDs           int
+	InOSDs           int
+	PGs              int
+	PGStates         map[string]int
+	PoolCount        int
+	ReadBytesPerSec  int64
+	WriteBytesPerSec int64
+	ReadOpsPerSec    int64
+	WriteOpsPerSec   int64
+	Quorum           []string
+}
+
+func NewOverview(overview *domain.Overview) *Overview {
+	return &Overview{
+		ClusterID:        overview.ClusterID(),
+		CollectedTime:    overview.CollectedTime(),
+		TotalBytes:       overview.Capacity().TotalBytes(),
+		UsedBytes:        overview.Capacity().UsedBytes(),
+		AvailBytes:       overview.Capacity().AvailBytes(),
+		OSDs:             overview.OSDs().Total(),
+		UpOSDs:           overview.OSDs().Up(),
+		InOSDs:           overview.OSDs().In(),
+		PGs:              overview.PGs().Total(),
+		PGStates:         overview.PGs().States(),
+		PoolCount:        overview.PoolCount(),
+		ReadBytesPerSec:  overview.ClientIO().ReadBytesPerSec(),
+		WriteBytesPerSec: overview.ClientIO().WriteBytesPerSec(),
+		ReadOpsPerSec:    overview.ClientIO().ReadOpsPerSec(),
+		WriteOpsPerSec:   overview.ClientIO().WriteOpsPerSec(),
+		Quorum:           overview.Quorum(),
+	}
+}
+
+func (o *Overview) ToDomain() (*domain.Overview, error) {
+	capacity, err := domain.NewCapacity(o.TotalBytes, o.UsedBytes, o.AvailBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain capacity: %w", err)
+	}
+
+	osds, err := domain.NewOSDCount(o.OSDs, o.UpOSDs, o.InOSDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain osd count: %w", err)
+	}
+
+	pgs, err := domain.NewPGCount(o.PGs, o.PGStates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain pg count: %w", err)
+	}
+
+	clientIO := domain.NewClientIO(o.ReadBytesPerSec, o.WriteBytesPerSec, o.ReadOpsPerSec, o.WriteOpsPerSec)
+
+	overview, err := domain.NewOverview(
+		o.ClusterID, o.CollectedTime, capacity, osds, pgs, o.PoolCount, clientIO, o.Quorum,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain overview: %w", err)
+	}
+
+	return overview, nil
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"time"
 
 	"github.com/neatflowcv/cepher/internal/pkg/domain"
 	"github.com/neatflowcv/cepher/internal/pkg/repository"
@@ -14,12 +17,14 @@ import (
 var _ repository.Repository = (*Repository)(nil)
 
 type Repository struct {
-	path string
+	path   string
+	logger *slog.Logger
 }
 
-func NewRepository(path string) *Repository {
+func NewRepository(path string, logger *slog.Logger) *Repository {
 	return &Repository{
-		path: path,
+		path:   path,
+		logger: logger,
 	}
 }
 
@@ -145,3 +150,369 @@ func (r *Repository) UpdateCluster(ctx context.Context, dCluster *domain.Cluster
 
 	return nil
 }
+
+func (r *Repository) DeleteCluster(ctx context.Context, id string) error {
+	path := filepath.Clean(filepath.Join(r.path, id+".json"))
+
+	err := os.Remove(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return repository.ErrClusterNotFound
+		}
+
+		return fmt.Errorf("failed to remove file: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) UpsertOverview(ctx context.Context, dOverview *domain.Overview) error {
+	dir := filepath.Join(r.path, "overviews")
+
+	const dirPermission = 0750
+
+	err := os.MkdirAll(dir, dirPermission)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	overview := NewOverview(dOverview)
+
+	data, err := json.MarshalIndent(overview, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal overview: %w", err)
+	}
+
+	const permission = 0600
+
+	err = os.WriteFile(filepath.Join(dir, dOverview.ClusterID()+".json"), data, permission)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) GetOverview(ctx context.Context, clusterID string) (*domain.Overview, error) {
+	path := filepath.Clean(filepath.Join(r.path, "overviews", clusterID+".json"))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, repository.ErrOverviewNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var overview Overview
+
+	err = json.Unmarshal(data, &overview)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal overview file %s: %w", path, err)
+	}
+
+	dOverview, err := overview.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert overview to domain: %w", err)
+	}
+
+	return dOverview, nil
+}
+
+func (r *Repository) AppendUsageSample(ctx context.Context, dSample *domain.UsageSample) error {
+	dir := filepath.Join(r.path, "usages")
+
+	const dirPermission = 0750
+
+	err := os.MkdirAll(dir, dirPermission)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.Marshal(NewUsageSample(dSample))
+	if err != nil {
+		return fmt.Errorf("failed to marshal usage sample: %w", err)
+	}
+
+	return r.appendLine(filepath.Join(dir, dSample.ClusterID()+".jsonl"), data)
+}
+
+func (r *Repository) ListUsageSamples(
+	ctx context.Context,
+	clusterID string,
+	since time.Time,
+) ([]*domain.UsageSample, error) {
+	path := filepath.Clean(filepath.Join(r.path, "usages", clusterID+".jsonl"))
+
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*domain.UsageSample
+
+	for _, line := range lines {
+		var sample UsageSample
+
+		err := json.Unmarshal(line, &sample)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal usage sample in %s: %w", path, err)
+		}
+
+		if sample.CollectedTime.Before(since) {
+			continue
+		}
+
+		dSample, err := sample.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert usage sample to domain: %w", err)
+		}
+
+		ret = append(ret, dSample)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) AppendStatusChange(ctx context.Context, dChange *domain.StatusChange) error {
+	dir := filepath.Join(r.path, "status_changes")
+
+	const dirPermission = 0750
+
+	err := os.MkdirAll(dir, dirPermission)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.Marshal(NewStatusChange(dChange))
+	if err != nil {
+		return fmt.Errorf("failed to marshal status change: %w", err)
+	}
+
+	return r.appendLine(filepath.Join(dir, dChange.ClusterID()+".jsonl"), data)
+}
+
+func (r *Repository) ListStatusChanges(ctx context.Context, clusterID string) ([]*domain.StatusChange, error) {
+	path := filepath.Clean(filepath.Join(r.path, "status_changes", clusterID+".jsonl"))
+
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*domain.StatusChange
+
+	for _, line := range lines {
+		var change StatusChange
+
+		err := json.Unmarshal(line, &change)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal status change in %s: %w", path, err)
+		}
+
+		dChange, err := change.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert status change to domain: %w", err)
+		}
+
+		ret = append(ret, dChange)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) CreateEvent(ctx context.Context, dEvent *domain.Event) error {
+	dir := filepath.Join(r.path, "events")
+
+	const dirPermission = 0750
+
+	err := os.MkdirAll(dir, dirPermission)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.Marshal(NewEvent(dEvent))
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	return r.appendLine(filepath.Join(dir, dEvent.ClusterID()+".jsonl"), data)
+}
+
+func (r *Repository) ListEvents(ctx context.Context, clusterID string) ([]*domain.Event, error) {
+	path := filepath.Clean(filepath.Join(r.path, "events", clusterID+".jsonl"))
+
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*domain.Event
+
+	for _, line := range lines {
+		var event Event
+
+		err := json.Unmarshal(line, &event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal event in %s: %w", path, err)
+		}
+
+		dEvent, err := event.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert event to domain: %w", err)
+		}
+
+		ret = append(ret, dEvent)
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) AppendAuditEntry(ctx context.Context, dEntry *domain.AuditEntry) error {
+	data, err := json.Marshal(NewAuditEntry(dEntry))
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	return r.appendLine(filepath.Join(r.path, "audit.jsonl"), data)
+}
+
+func (r *Repository) ListAuditEntries(
+	ctx context.Context,
+	filter *repository.AuditFilter,
+) ([]*domain.AuditEntry, error) {
+	path := filepath.Join(r.path, "audit.jsonl")
+
+	lines, err := readLines(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []*domain.AuditEntry
+
+	for _, line := range lines {
+		var entry AuditEntry
+
+		err := json.Unmarshal(line, &entry)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal audit entry in %s: %w", path, err)
+		}
+
+		dEntry, err := entry.ToDomain()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert audit entry to domain: %w", err)
+		}
+
+		if filter.Match(dEntry) {
+			ret = append(ret, dEntry)
+		}
+	}
+
+	return ret, nil
+}
+
+func (r *Repository) CreateJob(ctx context.Context, job *domain.Job) error {
+	return r.writeJob(job)
+}
+
+func (r *Repository) GetJob(ctx context.Context, id string) (*domain.Job, error) {
+	path := filepath.Clean(filepath.Join(r.path, "jobs", id+".json"))
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, repository.ErrJobNotFound
+		}
+
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return unmarshalJob(path, data)
+}
+
+func (r *Repository) UpdateJob(ctx context.Context, job *domain.Job) error {
+	return r.writeJob(job)
+}
+
+func (r *Repository) ListJobs(ctx context.Context) ([]*domain.Job, error) {
+	dir := filepath.Join(r.path, "jobs")
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	var ret []*domain.Job
+
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+
+		path := filepath.Clean(filepath.Join(dir, file.Name()))
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s: %w", path, err)
+		}
+
+		job, err := unmarshalJob(path, data)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, job)
+	}
+
+	slices.SortStableFunc(ret, func(a, b *domain.Job) int {
+		return a.CreatedTime().Compare(b.CreatedTime())
+	})
+
+	return ret, nil
+}
+
+func (r *Repository) writeJob(dJob *domain.Job) error {
+	dir := filepath.Join(r.path, "jobs")
+
+	const dirPermission = 0750
+
+	err := os.MkdirAll(dir, dirPermission)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(NewJob(dJob), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal job: %w", err)
+	}
+
+	const permission = 0600
+
+	err = os.WriteFile(filepath.Join(dir, dJob.ID()+".json"), data, permission)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+
+	return nil
+}
+
+func unmarshalJob(path string, data []byte) (*domain.Job, error) {
+	var job Job
+
+	err := json.Unmarshal(data, &job)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal job file %s: %w", path, err)
+	}
+
+	dJob, err := job.ToDomain()
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert job to domain: %w", err)
+	}
+
+	return dJob, nil
+}
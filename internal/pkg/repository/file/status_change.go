@@ -0,0 +1,35 @@
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type StatusChange struct {
+	ClusterID string
+	From      string
+	To        string
+	Time      time.Time
+}
+
+func NewStatusChange(change *domain.StatusChange) *StatusChange {
+	return &StatusChange{
+		ClusterID: change.ClusterID(),
+		From:      string(change.From()),
+		To:        string(change.To()),
+		Time:      change.Time(),
+	}
+}
+
+func (c *StatusChange) ToDomain() (*domain.StatusChange, error) {
+	change, err := domain.NewStatusChange(
+		c.ClusterID, domain.ClusterStatus(c.From), domain.ClusterStatus(c.To), c.Time,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain status change: %w", err)
+	}
+
+	return change, nil
+}
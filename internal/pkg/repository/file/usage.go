@@ -0,0 +1,71 @@
+package file
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type UsageSample struct {
+	ClusterID     string
+	CollectedTime time.Time
+	TotalBytes    int64
+	UsedBytes     int64
+	UsedRatio     float64
+	Pools         []*PoolUsage
+}
+
+type PoolUsage struct {
+	Name          string
+	StoredBytes   int64
+	UsedBytes     int64
+	MaxAvailBytes int64
+	UsedRatio     float64
+}
+
+func NewUsageSample(sample *domain.UsageSample) *UsageSample {
+	var pools []*PoolUsage
+	for _, pool := range sample.Pools() {
+		pools = append(pools, &PoolUsage{
+			Name:          pool.Name(),
+			StoredBytes:   pool.StoredBytes(),
+			UsedBytes:     pool.UsedBytes(),
+			MaxAvailBytes: pool.MaxAvailBytes(),
+			UsedRatio:     pool.UsedRatio(),
+		})
+	}
+
+	return &UsageSample{
+		ClusterID:     sample.ClusterID(),
+		CollectedTime: sample.CollectedTime(),
+		TotalBytes:    sample.TotalBytes(),
+		UsedBytes:     sample.UsedBytes(),
+		UsedRatio:     sample.UsedRatio(),
+		Pools:         pools,
+	}
+}
+
+func (s *UsageSample) ToDomain() (*domain.UsageSample, error) {
+	var pools []*domain.PoolUsage
+
+	for _, pool := range s.Pools {
+		usage, err := domain.NewPoolUsage(
+			pool.Name, pool.StoredBytes, pool.UsedBytes, pool.MaxAvailBytes, pool.UsedRatio,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain pool usage: %w", err)
+		}
+
+		pools = append(pools, usage)
+	}
+
+	sample, err := domain.NewUsageSample(
+		s.ClusterID, s.CollectedTime, s.TotalBytes, s.UsedBytes, s.UsedRatio, pools,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain usage sample: %w", err)
+	}
+
+	return sample, nil
+}
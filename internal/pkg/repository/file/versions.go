@@ -0,0 +1,66 @@
+package file
+
+import (
+	"fmt"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+)
+
+type Versions struct {
+	Release string
+	Daemons []*DaemonVersion
+}
+
+type DaemonVersion struct {
+	Daemon  string
+	Version string
+	Release string
+	Count   int
+}
+
+func NewVersions(versions *domain.Versions) *Versions {
+	if versions == nil {
+		return nil
+	}
+
+	var daemons []*DaemonVersion
+	for _, daemon := range versions.Daemons() {
+		daemons = append(daemons, &DaemonVersion{
+			Daemon:  daemon.Daemon(),
+			Version: daemon.Version(),
+			Release: string(daemon.Release()),
+			Count:   daemon.Count(),
+		})
+	}
+
+	return &Versions{
+		Release: string(versions.Release()),
+		Daemons: daemons,
+	}
+}
+
+func (v *Versions) ToDomain() (*domain.Versions, error) {
+	if v == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	var daemons []*domain.DaemonVersion
+
+	for _, daemon := range v.Daemons {
+		dDaemon, err := domain.NewDaemonVersion(
+			daemon.Daemon, daemon.Version, domain.Release(daemon.Release), daemon.Count,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create domain daemon version: %w", err)
+		}
+
+		daemons = append(daemons, dDaemon)
+	}
+
+	versions, err := domain.NewVersions(domain.Release(v.Release), daemons)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create domain versions: %w", err)
+	}
+
+	return versions, nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/neatflowcv/cepher/internal/pkg/domain"
 )
@@ -11,4 +12,33 @@ type Repository interface {
 	ListClusters(ctx context.Context) ([]*domain.Cluster, error)
 	GetCluster(ctx context.Context, id string) (*domain.Cluster, error)
 	UpdateCluster(ctx context.Context, cluster *domain.Cluster) error
+	// DeleteCluster는 cluster를 지운다. 등록을 마치지 못한 pending cluster를 지우는 데 쓴다.
+	DeleteCluster(ctx context.Context, id string) error
+
+	UpsertOverview(ctx context.Context, overview *domain.Overview) error
+	GetOverview(ctx context.Context, clusterID string) (*domain.Overview, error)
+
+	AppendUsageSample(ctx context.Context, sample *domain.UsageSample) error
+	// ListUsageSamples는 since 이후에 수집된 sample을 수집 시각 순으로 반환한다.
+	ListUsageSamples(ctx context.Context, clusterID string, since time.Time) ([]*domain.UsageSample, error)
+
+	// AppendStatusChange는 cluster의 status가 바뀐 기록을 추가한다.
+	AppendStatusChange(ctx context.Context, change *domain.StatusChange) error
+	// ListStatusChanges는 cluster의 status 변경 기록을 발생 순으로 반환한다.
+	ListStatusChanges(ctx context.Context, clusterID string) ([]*domain.StatusChange, error)
+
+	CreateEvent(ctx context.Context, event *domain.Event) error
+	// ListEvents는 cluster의 event를 발생 순으로 반환한다.
+	ListEvents(ctx context.Context, clusterID string) ([]*domain.Event, error)
+
+	// AppendAuditEntry는 audit log에 entry를 추가한다. 추가한 entry는 바꾸거나 지울 수 없다.
+	AppendAuditEntry(ctx context.Context, entry *domain.AuditEntry) error
+	// ListAuditEntries는 filter에 맞는 entry를 기록된 순으로 반환한다.
+	ListAuditEntries(ctx context.Context, filter *AuditFilter) ([]*domain.AuditEntry, error)
+
+	CreateJob(ctx context.Context, job *domain.Job) error
+	GetJob(ctx context.Context, id string) (*domain.Job, error)
+	UpdateJob(ctx context.Context, job *domain.Job) error
+	// ListJobs는 모든 job을 만든 순으로 반환한다.
+	ListJobs(ctx context.Context) ([]*domain.Job, error)
 }
@@ -0,0 +1,171 @@
+package traced
+
+import (
+	"context"
+	"time"
+
+	"github.com/neatflowcv/cepher/internal/pkg/domain"
+	"github.com/neatflowcv/cepher/internal/pkg/repository"
+	"github.com/neatflowcv/cepher/internal/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ repository.Repository = (*Repository)(nil)
+
+var tracer = otel.Tracer("github.com/neatflowcv/cepher/internal/pkg/repository") //nolint:gochecknoglobals
+
+// Repository는 다른 Repository의 호출마다 span을 남긴다.
+type Repository struct {
+	inner repository.Repository
+}
+
+func NewRepository(inner repository.Repository) *Repository {
+	return &Repository{
+		inner: inner,
+	}
+}
+
+func (r *Repository) start(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "Repository."+method) //nolint:spancheck
+}
+
+func (r *Repository) CreateCluster(ctx context.Context, cluster *domain.Cluster) (err error) {
+	ctx, span := r.start(ctx, "CreateCluster")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.CreateCluster(ctx, cluster) //nolint:wrapcheck
+}
+
+func (r *Repository) ListClusters(ctx context.Context) (_ []*domain.Cluster, err error) {
+	ctx, span := r.start(ctx, "ListClusters")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.ListClusters(ctx) //nolint:wrapcheck
+}
+
+func (r *Repository) GetCluster(ctx context.Context, id string) (_ *domain.Cluster, err error) {
+	ctx, span := r.start(ctx, "GetCluster")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.GetCluster(ctx, id) //nolint:wrapcheck
+}
+
+func (r *Repository) UpdateCluster(ctx context.Context, cluster *domain.Cluster) (err error) {
+	ctx, span := r.start(ctx, "UpdateCluster")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.UpdateCluster(ctx, cluster) //nolint:wrapcheck
+}
+
+func (r *Repository) DeleteCluster(ctx context.Context, id string) (err error) {
+	ctx, span := r.start(ctx, "DeleteCluster")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.DeleteCluster(ctx, id) //nolint:wrapcheck
+}
+
+func (r *Repository) UpsertOverview(ctx context.Context, overview *domain.Overview) (err error) {
+	ctx, span := r.start(ctx, "UpsertOverview")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.UpsertOverview(ctx, overview) //nolint:wrapcheck
+}
+
+func (r *Repository) GetOverview(ctx context.Context, clusterID string) (_ *domain.Overview, err error) {
+	ctx, span := r.start(ctx, "GetOverview")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.GetOverview(ctx, clusterID) //nolint:wrapcheck
+}
+
+func (r *Repository) AppendUsageSample(ctx context.Context, sample *domain.UsageSample) (err error) {
+	ctx, span := r.start(ctx, "AppendUsageSample")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.AppendUsageSample(ctx, sample) //nolint:wrapcheck
+}
+
+func (r *Repository) ListUsageSamples(
+	ctx context.Context,
+	clusterID string,
+	since time.Time,
+) (_ []*domain.UsageSample, err error) {
+	ctx, span := r.start(ctx, "ListUsageSamples")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.ListUsageSamples(ctx, clusterID, since) //nolint:wrapcheck
+}
+
+func (r *Repository) AppendStatusChange(ctx context.Context, change *domain.StatusChange) (err error) {
+	ctx, span := r.start(ctx, "AppendStatusChange")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.AppendStatusChange(ctx, change) //nolint:wrapcheck
+}
+
+func (r *Repository) ListStatusChanges(ctx context.Context, clusterID string) (_ []*domain.StatusChange, err error) {
+	ctx, span := r.start(ctx, "ListStatusChanges")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.ListStatusChanges(ctx, clusterID) //nolint:wrapcheck
+}
+
+func (r *Repository) CreateEvent(ctx context.Context, event *domain.Event) (err error) {
+	ctx, span := r.start(ctx, "CreateEvent")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.CreateEvent(ctx, event) //nolint:wrapcheck
+}
+
+func (r *Repository) ListEvents(ctx context.Context, clusterID string) (_ []*domain.Event, err error) {
+	ctx, span := r.start(ctx, "ListEvents")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.ListEvents(ctx, clusterID) //nolint:wrapcheck
+}
+
+func (r *Repository) AppendAuditEntry(ctx context.Context, entry *domain.AuditEntry) (err error) {
+	ctx, span := r.start(ctx, "AppendAuditEntry")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.AppendAuditEntry(ctx, entry) //nolint:wrapcheck
+}
+
+func (r *Repository) ListAuditEntries(
+	ctx context.Context,
+	filter *repository.AuditFilter,
+) (_ []*domain.AuditEntry, err error) {
+	ctx, span := r.start(ctx, "ListAuditEntries")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.ListAuditEntries(ctx, filter) //nolint:wrapcheck
+}
+
+func (r *Repository) CreateJob(ctx context.Context, job *domain.Job) (err error) {
+	ctx, span := r.start(ctx, "CreateJob")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.CreateJob(ctx, job) //nolint:wrapcheck
+}
+
+func (r *Repository) GetJob(ctx context.Context, id string) (_ *domain.Job, err error) {
+	ctx, span := r.start(ctx, "GetJob")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.GetJob(ctx, id) //nolint:wrapcheck
+}
+
+func (r *Repository) UpdateJob(ctx context.Context, job *domain.Job) (err error) {
+	ctx, span := r.start(ctx, "UpdateJob")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.UpdateJob(ctx, job) //nolint:wrapcheck
+}
+
+func (r *Repository) ListJobs(ctx context.Context) (_ []*domain.Job, err error) {
+	ctx, span := r.start(ctx, "ListJobs")
+	defer func() { tracing.End(span, err) }()
+
+	return r.inner.ListJobs(ctx) //nolint:wrapcheck
+}
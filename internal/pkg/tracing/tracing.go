@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const serviceName = "cepher"
+
+// NewProvider는 exporter로 span을 내보내는 TracerProvider를 만든다.
+// 시험할 때는 tracetest.NewInMemoryExporter를 넘기고 ForceFlush하면 span을 메모리에서 확인할 수 있다.
+func NewProvider(exporter sdktrace.SpanExporter, version string) (*sdktrace.TracerProvider, error) {
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	), nil
+}
+
+// Setup은 endpoint(예: http://localhost:4318)의 OTLP/HTTP collector로 span을 내보내도록 전역 TracerProvider를 설정한다.
+// endpoint가 비어 있으면 tracing을 켜지 않는다. 반환한 함수로 남은 span을 내보내고 종료한다.
+func Setup(ctx context.Context, endpoint string, version string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{},
+	))
+
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+	}
+
+	provider, err := NewProvider(exporter, version)
+	if err != nil {
+		return nil, err
+	}
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// End는 err가 있으면 span에 남기고 span을 끝낸다. defer와 함께 쓴다.
+//
+//	ctx, span := tracer.Start(ctx, "name")
+//	defer func() { tracing.End(span, err) }()
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
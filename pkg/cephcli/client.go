@@ -5,45 +5,48 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os/exec"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+var tracer = otel.Tracer("github.com/neatflowcv/cepher/pkg/cephcli") //nolint:gochecknoglobals
+
+// connectTimeout 동안 monitor에 연결하지 못하면 ceph 커맨드는 errno 110으로 실패한다.
+const connectTimeout = "30"
+
 type Client struct {
+	runner  Runner
 	path    string
+	name    string
+	monHost string
 	version string
 }
 
-func NewClient(path, version string) *Client {
+// NewClient는 path의 설정으로 name(entity) 사용자로 접속하는 client를 만든다. name이 비어 있으면 client.admin이다.
+// monHost가 있으면 ceph.conf의 mon_host 대신 이 값의 순서로 monitor에 접속한다.
+func NewClient(runner Runner, path, name, monHost, version string) *Client {
 	return &Client{
+		runner:  runner,
 		path:    path,
+		name:    name,
+		monHost: monHost,
 		version: version,
 	}
 }
 
 func (c *Client) HealthDetail(ctx context.Context) (*HealthDetail, error) {
-	image := "quay.io/ceph/ceph:v" + c.version
-	volume := c.path + ":/etc/ceph"
-	cmd := exec.CommandContext( //nolint:gosec
-		ctx,
-		"podman", "run", "--rm", "-v", volume, image, "ceph", "health", "detail", "-f", "json",
-	)
-
-	var (
-		stdout bytes.Buffer
-		stderr bytes.Buffer
-	)
-
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	err := cmd.Run()
+	stdout, err := c.execute(ctx, "health", "detail")
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute command: %w: %s", err, stderr.String())
+		return nil, err
 	}
 
 	var ret HealthDetail
 
-	err = json.NewDecoder(&stdout).Decode(&ret)
+	err = json.Unmarshal(stdout, &ret)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode health detail: %w", err)
 	}
@@ -52,32 +55,161 @@ func (c *Client) HealthDetail(ctx context.Context) (*HealthDetail, error) {
 }
 
 func (c *Client) MonDump(ctx context.Context) (*MonDump, error) {
-	image := "quay.io/ceph/ceph:v" + c.version
-	volume := c.path + ":/etc/ceph"
-	cmd := exec.CommandContext( //nolint:gosec
-		ctx,
-		"podman", "run", "--rm", "-v", volume, image, "ceph", "mon", "dump", "-f", "json",
-	)
+	stdout, err := c.execute(ctx, "mon", "dump")
+	if err != nil {
+		return nil, err
+	}
 
-	var (
-		stdout bytes.Buffer
-		stderr bytes.Buffer
-	)
+	var ret MonDump
 
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	err = json.Unmarshal(stdout, &ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode mon dump: %w", err)
+	}
 
-	err := cmd.Run()
+	return &ret, nil
+}
+
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	stdout, err := c.execute(ctx, "status")
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute command: %w: %s", err, stderr.String())
+		return nil, err
 	}
 
-	var ret MonDump
+	var ret Status
 
-	err = json.NewDecoder(&stdout).Decode(&ret)
+	err = json.Unmarshal(stdout, &ret)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode mon dump: %w", err)
+		return nil, fmt.Errorf("failed to decode status: %w", err)
+	}
+
+	return &ret, nil
+}
+
+func (c *Client) DF(ctx context.Context) (*DF, error) {
+	stdout, err := c.execute(ctx, "df")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret DF
+
+	err = json.Unmarshal(stdout, &ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode df: %w", err)
+	}
+
+	return &ret, nil
+}
+
+func (c *Client) DFDetail(ctx context.Context) (*DF, error) {
+	stdout, err := c.execute(ctx, "df", "detail")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret DF
+
+	err = json.Unmarshal(stdout, &ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode df detail: %w", err)
+	}
+
+	return &ret, nil
+}
+
+func (c *Client) OsdTree(ctx context.Context) (*OsdTree, error) {
+	stdout, err := c.execute(ctx, "osd", "tree")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret OsdTree
+
+	err = json.Unmarshal(stdout, &ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode osd tree: %w", err)
 	}
 
 	return &ret, nil
 }
+
+func (c *Client) OsdDF(ctx context.Context) (*OsdDF, error) {
+	stdout, err := c.execute(ctx, "osd", "df")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret OsdDF
+
+	err = json.Unmarshal(stdout, &ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode osd df: %w", err)
+	}
+
+	return &ret, nil
+}
+
+func (c *Client) Versions(ctx context.Context) (Versions, error) {
+	stdout, err := c.execute(ctx, "versions")
+	if err != nil {
+		return nil, err
+	}
+
+	var ret Versions
+
+	err = json.Unmarshal(stdout, &ret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode versions: %w", err)
+	}
+
+	return ret, nil
+}
+
+// Command는 임의의 ceph 커맨드를 실행하고 JSON 출력을 그대로 돌려준다.
+// 출력이 없으면 null을 돌려준다.
+func (c *Client) Command(ctx context.Context, args ...string) (json.RawMessage, error) {
+	stdout, err := c.execute(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	stdout = bytes.TrimSpace(stdout)
+	if len(stdout) == 0 {
+		return json.RawMessage("null"), nil
+	}
+
+	if !json.Valid(stdout) {
+		return nil, fmt.Errorf("%w: output is not json", ErrCommandFailed)
+	}
+
+	return json.RawMessage(stdout), nil
+}
+
+func (c *Client) execute(ctx context.Context, args ...string) ([]byte, error) {
+	command := strings.Join(args, " ")
+
+	ctx, span := tracer.Start(ctx, "ceph "+command, trace.WithAttributes(
+		attribute.String("ceph.command", command),
+		attribute.String("ceph.version", c.version),
+	))
+	defer span.End()
+
+	stdout, err := c.runner.Run(ctx, &Command{
+		ConfigDir: c.path,
+		Name:      c.name,
+		MonHost:   c.monHost,
+		Version:   c.version,
+		Args:      args,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+
+		return nil, err //nolint:wrapcheck
+	}
+
+	span.SetAttributes(attribute.Int("ceph.output_bytes", len(stdout)))
+
+	return stdout, nil
+}
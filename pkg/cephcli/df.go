@@ -0,0 +1,43 @@
+package cephcli
+
+type DF struct {
+	Stats        DFStats            `json:"stats"`
+	StatsByClass map[string]DFStats `json:"stats_by_class,omitempty"`
+	Pools        []DFPool           `json:"pools,omitempty"`
+}
+
+type DFStats struct {
+	TotalBytes        int64   `json:"total_bytes,omitempty"`
+	TotalAvailBytes   int64   `json:"total_avail_bytes,omitempty"`
+	TotalUsedBytes    int64   `json:"total_used_bytes,omitempty"`
+	TotalUsedRawBytes int64   `json:"total_used_raw_bytes,omitempty"`
+	TotalUsedRawRatio float64 `json:"total_used_raw_ratio,omitempty"`
+	NumOsds           int     `json:"num_osds,omitempty"`
+}
+
+type DFPool struct {
+	Name  string      `json:"name,omitempty"`
+	ID    int         `json:"id,omitempty"`
+	Stats DFPoolStats `json:"stats"`
+}
+
+// DFPoolStats의 Quota 이후 필드는 `ceph df detail`에서만 채워진다.
+type DFPoolStats struct {
+	Stored             int64   `json:"stored,omitempty"`
+	Objects            int64   `json:"objects,omitempty"`
+	KbUsed             int64   `json:"kb_used,omitempty"`
+	BytesUsed          int64   `json:"bytes_used,omitempty"`
+	PercentUsed        float64 `json:"percent_used,omitempty"`
+	MaxAvail           int64   `json:"max_avail,omitempty"`
+	QuotaObjects       int64   `json:"quota_objects,omitempty"`
+	QuotaBytes         int64   `json:"quota_bytes,omitempty"`
+	Dirty              int64   `json:"dirty,omitempty"`
+	Rd                 int64   `json:"rd,omitempty"`
+	RdBytes            int64   `json:"rd_bytes,omitempty"`
+	Wr                 int64   `json:"wr,omitempty"`
+	WrBytes            int64   `json:"wr_bytes,omitempty"`
+	CompressBytesUsed  int64   `json:"compress_bytes_used,omitempty"`
+	CompressUnderBytes int64   `json:"compress_under_bytes,omitempty"`
+	StoredRaw          int64   `json:"stored_raw,omitempty"`
+	AvailRaw           int64   `json:"avail_raw,omitempty"`
+}
@@ -0,0 +1,10 @@
+package cephcli
+
+import "errors"
+
+var (
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrTimedOut         = errors.New("timed out")
+	ErrRuntime          = errors.New("container runtime error")
+	ErrCommandFailed    = errors.New("command failed")
+)
@@ -0,0 +1,71 @@
+package cephcli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var _ Runner = (*FakeRunner)(nil)
+
+// FakeRunner는 미리 기록해 둔 출력을 돌려주는 Runner이다.
+// ceph를 띄우지 않고 JSON 파싱을 확인할 때 쓴다.
+type FakeRunner struct {
+	mu       sync.Mutex
+	outputs  map[string][]byte
+	errors   map[string]error
+	commands []*Command
+}
+
+func NewFakeRunner() *FakeRunner {
+	return &FakeRunner{ //nolint:exhaustruct
+		outputs: make(map[string][]byte),
+		errors:  make(map[string]error),
+	}
+}
+
+// SetOutput은 args로 실행했을 때 돌려줄 stdout을 기록한다. 예: SetOutput(data, "health", "detail")
+func (r *FakeRunner) SetOutput(output []byte, args ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.outputs[strings.Join(args, " ")] = output
+}
+
+// SetError는 args로 실행했을 때 돌려줄 오류를 기록한다.
+func (r *FakeRunner) SetError(err error, args ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.errors[strings.Join(args, " ")] = err
+}
+
+// Commands는 지금까지 실행된 커맨드이다.
+func (r *FakeRunner) Commands() []*Command {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Command(nil), r.commands...)
+}
+
+func (r *FakeRunner) Run(_ context.Context, command *Command) ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.commands = append(r.commands, command)
+
+	key := strings.Join(command.Args, " ")
+
+	err, ok := r.errors[key]
+	if ok {
+		return nil, err
+	}
+
+	output, ok := r.outputs[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: no recorded output for %q", ErrCommandFailed, key)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,38 @@
+package cephcli
+
+type OsdDF struct {
+	Nodes   []OsdDFNode  `json:"nodes,omitempty"`
+	Stray   []OsdDFNode  `json:"stray,omitempty"`
+	Summary OsdDFSummary `json:"summary"`
+}
+
+type OsdDFNode struct {
+	ID          int     `json:"id"`
+	DeviceClass string  `json:"device_class,omitempty"`
+	Name        string  `json:"name,omitempty"`
+	Type        string  `json:"type,omitempty"`
+	TypeID      int     `json:"type_id,omitempty"`
+	CrushWeight float64 `json:"crush_weight,omitempty"`
+	Depth       int     `json:"depth,omitempty"`
+	Reweight    float64 `json:"reweight,omitempty"`
+	Kb          int64   `json:"kb,omitempty"`
+	KbUsed      int64   `json:"kb_used,omitempty"`
+	KbUsedData  int64   `json:"kb_used_data,omitempty"`
+	KbUsedOmap  int64   `json:"kb_used_omap,omitempty"`
+	KbUsedMeta  int64   `json:"kb_used_meta,omitempty"`
+	KbAvail     int64   `json:"kb_avail,omitempty"`
+	Utilization float64 `json:"utilization,omitempty"`
+	Var         float64 `json:"var,omitempty"`
+	Pgs         int     `json:"pgs,omitempty"`
+	Status      string  `json:"status,omitempty"`
+}
+
+type OsdDFSummary struct {
+	TotalKb            int64   `json:"total_kb,omitempty"`
+	TotalKbUsed        int64   `json:"total_kb_used,omitempty"`
+	TotalKbAvail       int64   `json:"total_kb_avail,omitempty"`
+	AverageUtilization float64 `json:"average_utilization,omitempty"`
+	MinVar             float64 `json:"min_var,omitempty"`
+	MaxVar             float64 `json:"max_var,omitempty"`
+	Dev                float64 `json:"dev,omitempty"`
+}
@@ -0,0 +1,21 @@
+package cephcli
+
+type OsdTree struct {
+	Nodes []OsdTreeNode `json:"nodes,omitempty"`
+	Stray []OsdTreeNode `json:"stray,omitempty"`
+}
+
+type OsdTreeNode struct {
+	ID              int     `json:"id"`
+	Name            string  `json:"name,omitempty"`
+	Type            string  `json:"type,omitempty"`
+	TypeID          int     `json:"type_id,omitempty"`
+	Children        []int   `json:"children,omitempty"`
+	DeviceClass     string  `json:"device_class,omitempty"`
+	CrushWeight     float64 `json:"crush_weight,omitempty"`
+	Depth           int     `json:"depth,omitempty"`
+	Exists          int     `json:"exists,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	Reweight        float64 `json:"reweight,omitempty"`
+	PrimaryAffinity float64 `json:"primary_affinity,omitempty"`
+}
@@ -0,0 +1,191 @@
+package cephcli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Command는 실행할 ceph 커맨드이다.
+// ConfigDir에는 ceph.conf와 ceph.<Name>.keyring이 있어야 한다.
+type Command struct {
+	ConfigDir string
+	// Name은 접속할 entity이다. 비어 있으면 client.admin이다.
+	Name string
+	// MonHost가 있으면 ceph.conf의 mon_host 대신 이 값의 순서로 monitor에 접속한다.
+	MonHost string
+	Version string
+	Args    []string
+}
+
+func (c *Command) name() string {
+	if c.Name == "" {
+		return "client.admin"
+	}
+
+	return c.Name
+}
+
+// Runner는 ceph 커맨드를 실행하고 stdout을 돌려준다.
+// 실패하면 ErrPermissionDenied, ErrTimedOut, ErrRuntime, ErrCommandFailed 중 하나로 분류한 오류를 반환한다.
+type Runner interface {
+	Run(ctx context.Context, command *Command) ([]byte, error)
+}
+
+const DefaultImage = "quay.io/ceph/ceph"
+
+var _ Runner = (*ContainerRunner)(nil)
+
+// ContainerRunner는 ceph 이미지를 컨테이너로 띄워 커맨드를 실행한다.
+// podman, docker, nerdctl은 run 인자가 같으므로 engine만 바꿔서 쓴다.
+type ContainerRunner struct {
+	engine string
+	image  string
+}
+
+func NewContainerRunner(engine, image string) *ContainerRunner {
+	return &ContainerRunner{
+		engine: engine,
+		image:  image,
+	}
+}
+
+func NewPodmanRunner() *ContainerRunner {
+	return NewContainerRunner("podman", DefaultImage)
+}
+
+func NewDockerRunner() *ContainerRunner {
+	return NewContainerRunner("docker", DefaultImage)
+}
+
+func NewNerdctlRunner() *ContainerRunner {
+	return NewContainerRunner("nerdctl", DefaultImage)
+}
+
+func (r *ContainerRunner) Run(ctx context.Context, command *Command) ([]byte, error) {
+	image := r.image + ":v" + command.Version
+	volume := command.ConfigDir + ":/etc/ceph"
+
+	// keyring은 ceph가 name에 맞춰 /etc/ceph/ceph.<name>.keyring에서 찾는다.
+	args := []string{"run", "--rm", "-v", volume, image, "ceph", "--name", command.name()}
+	args = append(args, cephArgs(command)...)
+
+	stdout, stderr, err := run(ctx, r.engine, args)
+	if err != nil {
+		var exitErr *exec.ExitError
+		if ctx.Err() == nil && errors.As(err, &exitErr) && isRuntimeExitCode(exitErr.ExitCode()) {
+			return nil, fmt.Errorf("%w: %w: %s", ErrRuntime, err, stderr)
+		}
+
+		return nil, classifyError(ctx, err, stderr)
+	}
+
+	return stdout, nil
+}
+
+// isRuntimeExitCode는 종료 코드가 ceph가 아니라 컨테이너 엔진의 오류인지 확인한다.
+// podman, docker, nerdctl 모두 자신의 오류를 125, 126, 127 종료 코드로 구분한다.
+func isRuntimeExitCode(code int) bool {
+	const (
+		exitRuntimeError  = 125
+		exitCannotInvoke  = 126
+		exitCommandAbsent = 127
+	)
+
+	switch code {
+	case exitRuntimeError, exitCannotInvoke, exitCommandAbsent:
+		return true
+	default:
+		return false
+	}
+}
+
+var _ Runner = (*BinaryRunner)(nil)
+
+// BinaryRunner는 호스트에 설치된 ceph 바이너리로 커맨드를 실행한다.
+// 설치된 ceph-common의 버전을 그대로 쓰므로 Command.Version은 무시한다.
+type BinaryRunner struct {
+	path string
+}
+
+func NewBinaryRunner(path string) *BinaryRunner {
+	return &BinaryRunner{
+		path: path,
+	}
+}
+
+func (r *BinaryRunner) Run(ctx context.Context, command *Command) ([]byte, error) {
+	args := []string{
+		"--conf", filepath.Join(command.ConfigDir, "ceph.conf"),
+		"--name", command.name(),
+		"--keyring", filepath.Join(command.ConfigDir, "ceph."+command.name()+".keyring"),
+	}
+	args = append(args, cephArgs(command)...)
+
+	stdout, stderr, err := run(ctx, r.path, args)
+	if err != nil {
+		return nil, classifyError(ctx, err, stderr)
+	}
+
+	return stdout, nil
+}
+
+func cephArgs(command *Command) []string {
+	ret := []string{"--connect-timeout", connectTimeout}
+	if command.MonHost != "" {
+		ret = append(ret, "-m", command.MonHost)
+	}
+
+	ret = append(ret, command.Args...)
+	ret = append(ret, "-f", "json")
+
+	return ret
+}
+
+func run(ctx context.Context, name string, args []string) ([]byte, string, error) {
+	cmd := exec.CommandContext(ctx, name, args...)
+
+	var (
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+	)
+
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return nil, stderr.String(), err //nolint:wrapcheck
+	}
+
+	return stdout.Bytes(), "", nil
+}
+
+func classifyError(ctx context.Context, err error, stderr string) error {
+	if ctx.Err() != nil {
+		return fmt.Errorf("%w: %w", ErrTimedOut, ctx.Err())
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return fmt.Errorf("%w: %w", ErrRuntime, err)
+	}
+
+	lower := strings.ToLower(stderr)
+
+	switch {
+	case strings.Contains(lower, "errno 13"),
+		strings.Contains(lower, "permission denied"),
+		strings.Contains(lower, "handle_auth_bad_method"):
+		return fmt.Errorf("%w: %s", ErrPermissionDenied, stderr)
+	case strings.Contains(lower, "errno 110"),
+		strings.Contains(lower, "timed out"):
+		return fmt.Errorf("%w: %s", ErrTimedOut, stderr)
+	default:
+		return fmt.Errorf("%w: %w: %s", ErrCommandFailed, err, stderr)
+	}
+}
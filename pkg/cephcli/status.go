@@ -0,0 +1,49 @@
+package cephcli
+
+type Status struct {
+	Fsid          string       `json:"fsid,omitempty"`
+	Health        HealthDetail `json:"health"`
+	ElectionEpoch int          `json:"election_epoch,omitempty"`
+	Quorum        []int        `json:"quorum,omitempty"`
+	QuorumNames   []string     `json:"quorum_names,omitempty"`
+	QuorumAge     int          `json:"quorum_age,omitempty"`
+	Monmap        StatusMonmap `json:"monmap"`
+	Osdmap        StatusOsdmap `json:"osdmap"`
+	Pgmap         StatusPgmap  `json:"pgmap"`
+}
+
+type StatusMonmap struct {
+	Epoch             int    `json:"epoch,omitempty"`
+	MinMonReleaseName string `json:"min_mon_release_name,omitempty"`
+	NumMons           int    `json:"num_mons,omitempty"`
+}
+
+type StatusOsdmap struct {
+	Epoch          int   `json:"epoch,omitempty"`
+	NumOsds        int   `json:"num_osds,omitempty"`
+	NumUpOsds      int   `json:"num_up_osds,omitempty"`
+	OsdUpSince     int64 `json:"osd_up_since,omitempty"`
+	NumInOsds      int   `json:"num_in_osds,omitempty"`
+	OsdInSince     int64 `json:"osd_in_since,omitempty"`
+	NumRemappedPgs int   `json:"num_remapped_pgs,omitempty"`
+}
+
+type PgsByState struct {
+	StateName string `json:"state_name,omitempty"`
+	Count     int    `json:"count,omitempty"`
+}
+
+type StatusPgmap struct {
+	PgsByState    []PgsByState `json:"pgs_by_state,omitempty"`
+	NumPgs        int          `json:"num_pgs,omitempty"`
+	NumPools      int          `json:"num_pools,omitempty"`
+	NumObjects    int64        `json:"num_objects,omitempty"`
+	DataBytes     int64        `json:"data_bytes,omitempty"`
+	BytesUsed     int64        `json:"bytes_used,omitempty"`
+	BytesAvail    int64        `json:"bytes_avail,omitempty"`
+	BytesTotal    int64        `json:"bytes_total,omitempty"`
+	ReadBytesSec  int64        `json:"read_bytes_sec,omitempty"`
+	WriteBytesSec int64        `json:"write_bytes_sec,omitempty"`
+	ReadOpPerSec  int64        `json:"read_op_per_sec,omitempty"`
+	WriteOpPerSec int64        `json:"write_op_per_sec,omitempty"`
+}
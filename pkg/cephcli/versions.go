@@ -0,0 +1,5 @@
+package cephcli
+
+// Versions는 `ceph versions`의 결과이다.
+// daemon 종류("mon", "mgr", "osd", "mds", "rgw", "overall" 등)마다 버전 문자열별 daemon 수를 담는다.
+type Versions map[string]map[string]int
@@ -7,7 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 )
 
@@ -119,7 +119,7 @@ func (c *Client) GetHealthFull(ctx context.Context, token string) error {
 		return fmt.Errorf("%w: %d: %s", ErrUnexpectedStatusCode, code, string(content))
 	}
 
-	log.Println("cluster", string(content))
+	slog.Info("cluster", "content", string(content))
 
 	return nil
 }
@@ -133,7 +133,7 @@ func doRequest(client *http.Client, req *http.Request) (int, []byte, error) {
 	defer func() {
 		err := resp.Body.Close()
 		if err != nil {
-			log.Printf("failed to close response body: %v", err)
+			slog.Warn("failed to close response body", "error", err)
 		}
 	}()
 
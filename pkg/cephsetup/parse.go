@@ -0,0 +1,396 @@
+package cephsetup
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidFormat  = errors.New("invalid format")
+	ErrMonHostMissing = errors.New("mon_host is missing")
+	ErrEntityNotFound = errors.New("entity not found in keyring")
+)
+
+const (
+	defaultV1Port = 6789
+	defaultV2Port = 3300
+)
+
+// Config는 ceph.conf에서 cluster에 접속하는 데 필요한 값이다.
+type Config struct {
+	// Fsid는 [global]의 fsid이다. 없으면 빈 문자열이다.
+	Fsid string
+	// MonHosts의 각 항목은 한 monitor의 주소들이다. 주소는 "v2:10.0.0.1:3300"처럼 port까지 채운 형태이다.
+	MonHosts [][]string
+}
+
+// KeyringEntry는 keyring의 section 하나이다.
+type KeyringEntry struct {
+	Entity string
+	Key    string
+}
+
+// ParseConfig는 ceph.conf를 읽는다.
+// [global]의 mon_host를 쓰고, 없으면 [mon.<id>] section의 mon_addr를 모은다.
+// ceph처럼 설정 이름의 공백, "-", "_"는 구분하지 않는다.
+func ParseConfig(reader io.Reader) (*Config, error) {
+	sections, err := parseINI(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	global := sections["global"]
+
+	config := &Config{
+		Fsid:     global["fsid"],
+		MonHosts: nil,
+	}
+
+	if monHost := global["mon_host"]; monHost != "" {
+		config.MonHosts, err = ParseMonHost(monHost)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, name := range slices.Sorted(maps.Keys(sections)) {
+			values := sections[name]
+			if !strings.HasPrefix(name, "mon.") || values["mon_addr"] == "" {
+				continue
+			}
+
+			monHosts, err := ParseMonHost(values["mon_addr"])
+			if err != nil {
+				return nil, err
+			}
+
+			config.MonHosts = append(config.MonHosts, monHosts...)
+		}
+	}
+
+	if len(config.MonHosts) == 0 {
+		return nil, ErrMonHostMissing
+	}
+
+	return config, nil
+}
+
+// ParseMonHost는 mon_host 값을 monitor별 주소로 나눈다.
+// "10.0.0.1,10.0.0.2:6789", "[v2:10.0.0.1:3300,v1:10.0.0.1:6789] [v2:...]", "v2:10.0.0.1:3300/0" 형태를 지원한다.
+// port가 없는 주소는 ceph처럼 v2(3300)와 v1(6789)을 모두 쓴다.
+func ParseMonHost(value string) ([][]string, error) {
+	var ret [][]string
+
+	for _, item := range splitTopLevel(value, ",; \t") {
+		if isAddrvec(item) {
+			var addrs []string
+
+			for _, addr := range splitTopLevel(item[1:len(item)-1], ",") {
+				parsed, err := parseMonAddr(addr)
+				if err != nil {
+					return nil, err
+				}
+
+				addrs = append(addrs, parsed...)
+			}
+
+			ret = append(ret, addrs)
+
+			continue
+		}
+
+		addrs, err := parseMonAddr(item)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, addrs)
+	}
+
+	return ret, nil
+}
+
+// isAddrvec은 "[v2:...,v1:...]"처럼 한 monitor의 주소를 묶은 것인지 확인한다.
+// "[::1]:6789"처럼 IPv6 주소를 감싼 대괄호와 구분한다.
+func isAddrvec(item string) bool {
+	if !strings.HasPrefix(item, "[") || !strings.HasSuffix(item, "]") {
+		return false
+	}
+
+	inner := item[1:]
+
+	return strings.HasPrefix(inner, "v1:") || strings.HasPrefix(inner, "v2:") || strings.HasPrefix(inner, "any:")
+}
+
+// splitTopLevel은 대괄호 밖에 있는 separators로 value를 나눈다. 빈 항목은 버린다.
+func splitTopLevel(value string, separators string) []string {
+	var (
+		ret   []string
+		depth int
+		start int
+	)
+
+	for i, r := range value {
+		switch {
+		case r == '[':
+			depth++
+		case r == ']':
+			depth--
+		case depth == 0 && strings.ContainsRune(separators, r):
+			if item := strings.TrimSpace(value[start:i]); item != "" {
+				ret = append(ret, item)
+			}
+
+			start = i + 1
+		}
+	}
+
+	if item := strings.TrimSpace(value[start:]); item != "" {
+		ret = append(ret, item)
+	}
+
+	return ret
+}
+
+// parseMonAddr는 주소 하나를 "v2:host:port" 형태로 바꾼다.
+func parseMonAddr(addr string) ([]string, error) {
+	// "v2:10.0.0.1:3300/0"의 "/0"은 nonce이다.
+	if i := strings.LastIndex(addr, "/"); i >= 0 {
+		addr = addr[:i]
+	}
+
+	protocol := ""
+
+	for _, prefix := range []string{"v1", "v2", "any"} {
+		rest, ok := strings.CutPrefix(addr, prefix+":")
+		if ok {
+			protocol = prefix
+			addr = rest
+
+			break
+		}
+	}
+
+	if protocol == "any" {
+		protocol = ""
+	}
+
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		// port가 없는 주소이다.
+		host = strings.TrimSuffix(strings.TrimPrefix(addr, "["), "]")
+		port = ""
+	}
+
+	if host == "" {
+		return nil, fmt.Errorf("%w: mon address %q", ErrInvalidFormat, addr)
+	}
+
+	if port != "" {
+		_, err := strconv.Atoi(port)
+		if err != nil {
+			return nil, fmt.Errorf("%w: mon address %q", ErrInvalidFormat, addr)
+		}
+
+		return []string{joinMonAddr(protocol, host, port)}, nil
+	}
+
+	switch protocol {
+	case "v1":
+		return []string{joinMonAddr(protocol, host, strconv.Itoa(defaultV1Port))}, nil
+	case "v2":
+		return []string{joinMonAddr(protocol, host, strconv.Itoa(defaultV2Port))}, nil
+	default:
+		return []string{
+			joinMonAddr("v2", host, strconv.Itoa(defaultV2Port)),
+			joinMonAddr("v1", host, strconv.Itoa(defaultV1Port)),
+		}, nil
+	}
+}
+
+func joinMonAddr(protocol, host, port string) string {
+	hostPort := net.JoinHostPort(host, port)
+	if protocol == "" {
+		return hostPort
+	}
+
+	return protocol + ":" + hostPort
+}
+
+// ParseKeyring은 keyring의 section마다 entity와 key를 읽는다.
+func ParseKeyring(reader io.Reader) ([]*KeyringEntry, error) {
+	var ret []*KeyringEntry
+
+	var current *KeyringEntry
+
+	err := scanINI(reader, func(section string) {
+		current = &KeyringEntry{Entity: section, Key: ""}
+		ret = append(ret, current)
+	}, func(name, value string) error {
+		if current == nil {
+			return fmt.Errorf("%w: %s is outside of a section", ErrInvalidFormat, name)
+		}
+
+		if name == "key" {
+			current.Key = value
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range ret {
+		if entry.Key == "" {
+			return nil, fmt.Errorf("%w: %s has no key", ErrInvalidFormat, entry.Entity)
+		}
+	}
+
+	return ret, nil
+}
+
+// FindKeyringEntry는 entity의 entry를 찾는다.
+// entity가 비어 있으면 client.admin을 찾고, 없으면 keyring에 client entity가 하나뿐일 때 그것을 쓴다.
+func FindKeyringEntry(entries []*KeyringEntry, entity string) (*KeyringEntry, error) {
+	wanted := entity
+	if wanted == "" {
+		wanted = DefaultEntity
+	}
+
+	var clients []*KeyringEntry
+
+	for _, entry := range entries {
+		if entry.Entity == wanted {
+			return entry, nil
+		}
+
+		if strings.HasPrefix(entry.Entity, "client.") {
+			clients = append(clients, entry)
+		}
+	}
+
+	if entity == "" && len(clients) == 1 {
+		return clients[0], nil
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrEntityNotFound, wanted)
+}
+
+// parseINI는 section 이름별로 설정 값을 모은다. 설정 이름은 normalizeName으로 바꾼다.
+func parseINI(reader io.Reader) (map[string]map[string]string, error) {
+	ret := map[string]map[string]string{}
+
+	var current map[string]string
+
+	err := scanINI(reader, func(section string) {
+		current = ret[section]
+		if current == nil {
+			current = map[string]string{}
+			ret[section] = current
+		}
+	}, func(name, value string) error {
+		if current == nil {
+			return fmt.Errorf("%w: %s is outside of a section", ErrInvalidFormat, name)
+		}
+
+		current[name] = value
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// scanINI는 ceph.conf와 keyring이 쓰는 INI 형식을 한 줄씩 읽는다.
+// "#"과 ";" 뒤는 주석이고, 줄 끝의 "\"는 다음 줄로 이어진다.
+func scanINI(reader io.Reader, onSection func(string), onValue func(name, value string) error) error {
+	scanner := bufio.NewScanner(reader)
+
+	var pending string
+
+	for scanner.Scan() {
+		line := pending + scanner.Text()
+		pending = ""
+
+		if strings.HasSuffix(line, "\\") {
+			pending = strings.TrimSuffix(line, "\\")
+
+			continue
+		}
+
+		line = strings.TrimSpace(stripComment(line))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return fmt.Errorf("%w: section %q", ErrInvalidFormat, line)
+			}
+
+			onSection(strings.TrimSpace(line[1 : len(line)-1]))
+
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return fmt.Errorf("%w: line %q", ErrInvalidFormat, line)
+		}
+
+		err := onValue(normalizeName(name), unquote(strings.TrimSpace(value)))
+		if err != nil {
+			return err
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		return fmt.Errorf("failed to read: %w", err)
+	}
+
+	return nil
+}
+
+// stripComment는 따옴표 밖의 "#"이나 ";"부터 줄 끝까지를 지운다.
+func stripComment(line string) string {
+	quoted := false
+
+	for i, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case !quoted && (r == '#' || r == ';'):
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		return value[1 : len(value)-1]
+	}
+
+	return value
+}
+
+// normalizeName은 "mon host", "mon-host", "mon_host"를 모두 "mon_host"로 바꾼다.
+func normalizeName(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(strings.TrimSpace(name)), func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_' || r == '\t'
+	})
+
+	return strings.Join(fields, "_")
+}
@@ -0,0 +1,212 @@
+package cephsetup_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/neatflowcv/cepher/pkg/cephsetup"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		conf     string
+		wantFsid string
+		want     [][]string
+	}{
+		{
+			name: "hosts without port",
+			conf: "[global]\nfsid = 6f1c9a2e\nmon_host = 10.0.0.1,10.0.0.2:6789\n",
+			want: [][]string{
+				{"v2:10.0.0.1:3300", "v1:10.0.0.1:6789"},
+				{"10.0.0.2:6789"},
+			},
+			wantFsid: "6f1c9a2e",
+		},
+		{
+			name: "addrvec with nonce",
+			conf: "[global]\nmon_host = [v2:10.0.0.1:3300/0,v1:10.0.0.1:6789/0] [v2:10.0.0.2:3300/0,v1:10.0.0.2:6789/0]\n",
+			want: [][]string{
+				{"v2:10.0.0.1:3300", "v1:10.0.0.1:6789"},
+				{"v2:10.0.0.2:3300", "v1:10.0.0.2:6789"},
+			},
+		},
+		{
+			name: "spelling, comments and quotes",
+			conf: "# generated\n[global]\n\tMon Host = \"v1:10.0.0.1\" ; first monitor\n\tfsid=6f1c9a2e # cluster\n",
+			want: [][]string{
+				{"v1:10.0.0.1:6789"},
+			},
+			wantFsid: "6f1c9a2e",
+		},
+		{
+			name: "line continuation",
+			conf: "[global]\nmon_host = v2:10.0.0.1:3300,\\\n  v2:10.0.0.2:3300\n",
+			want: [][]string{
+				{"v2:10.0.0.1:3300"},
+				{"v2:10.0.0.2:3300"},
+			},
+		},
+		{
+			name: "ipv6",
+			conf: "[global]\nmon_host = [::1]:6789 v2:[::2]\n",
+			want: [][]string{
+				{"[::1]:6789"},
+				{"v2:[::2]:3300"},
+			},
+		},
+		{
+			name: "mon sections without mon_host",
+			conf: "[mon.b]\nmon_addr = 10.0.0.2\n[mon.a]\nmon addr = v1:10.0.0.1\n[osd.0]\nhost = node-a\n",
+			want: [][]string{
+				{"v1:10.0.0.1:6789"},
+				{"v2:10.0.0.2:3300", "v1:10.0.0.2:6789"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cephsetup.ParseConfig(strings.NewReader(tt.conf))
+			if err != nil {
+				t.Fatalf("ParseConfig() error = %v", err)
+			}
+
+			if got.Fsid != tt.wantFsid {
+				t.Errorf("fsid = %q, want %q", got.Fsid, tt.wantFsid)
+			}
+
+			if !reflect.DeepEqual(got.MonHosts, tt.want) {
+				t.Errorf("mon hosts = %v, want %v", got.MonHosts, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConfig_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		conf string
+		want error
+	}{
+		{name: "no mon_host", conf: "[global]\nfsid = 6f1c9a2e\n", want: cephsetup.ErrMonHostMissing},
+		{name: "empty", conf: "", want: cephsetup.ErrMonHostMissing},
+		{name: "value outside section", conf: "mon_host = 10.0.0.1\n", want: cephsetup.ErrInvalidFormat},
+		{name: "unclosed section", conf: "[global\nmon_host = 10.0.0.1\n", want: cephsetup.ErrInvalidFormat},
+		{name: "line without value", conf: "[global]\nmon_host\n", want: cephsetup.ErrInvalidFormat},
+		{name: "invalid port", conf: "[global]\nmon_host = 10.0.0.1:abc\n", want: cephsetup.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cephsetup.ParseConfig(strings.NewReader(tt.conf))
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ParseConfig() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKeyring(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		keyring string
+		want    []*cephsetup.KeyringEntry
+		wantErr error
+	}{
+		{
+			name: "several entities with caps",
+			keyring: "[client.admin]\n\tkey = AQAdmin==\n\tcaps mon = \"allow *\"\n" +
+				"[client.monitoring]\n\tkey = \"AQMonitoring==\"\n\tcaps mon = \"allow r\"\n",
+			want: []*cephsetup.KeyringEntry{
+				{Entity: "client.admin", Key: "AQAdmin=="},
+				{Entity: "client.monitoring", Key: "AQMonitoring=="},
+			},
+		},
+		{
+			name:    "entity without key",
+			keyring: "[client.admin]\n\tcaps mon = \"allow *\"\n",
+			wantErr: cephsetup.ErrInvalidFormat,
+		},
+		{
+			name:    "key outside section",
+			keyring: "key = AQAdmin==\n",
+			wantErr: cephsetup.ErrInvalidFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cephsetup.ParseKeyring(strings.NewReader(tt.keyring))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseKeyring() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseKeyring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindKeyringEntry(t *testing.T) {
+	t.Parallel()
+
+	admin := &cephsetup.KeyringEntry{Entity: "client.admin", Key: "AQAdmin=="}
+	monitoring := &cephsetup.KeyringEntry{Entity: "client.monitoring", Key: "AQMonitoring=="}
+	backup := &cephsetup.KeyringEntry{Entity: "client.backup", Key: "AQBackup=="}
+	mgr := &cephsetup.KeyringEntry{Entity: "mgr.a", Key: "AQMgr=="}
+
+	tests := []struct {
+		name    string
+		entries []*cephsetup.KeyringEntry
+		entity  string
+		want    *cephsetup.KeyringEntry
+		wantErr error
+	}{
+		{name: "named entity", entries: []*cephsetup.KeyringEntry{admin, monitoring}, entity: "client.monitoring", want: monitoring},
+		{name: "default is client.admin", entries: []*cephsetup.KeyringEntry{monitoring, admin}, entity: "", want: admin},
+		{name: "only client", entries: []*cephsetup.KeyringEntry{mgr, monitoring}, entity: "", want: monitoring},
+		{
+			name:    "several clients without admin",
+			entries: []*cephsetup.KeyringEntry{monitoring, backup},
+			entity:  "",
+			wantErr: cephsetup.ErrEntityNotFound,
+		},
+		{
+			name:    "named entity missing",
+			entries: []*cephsetup.KeyringEntry{monitoring},
+			entity:  "client.admin",
+			wantErr: cephsetup.ErrEntityNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cephsetup.FindKeyringEntry(tt.entries, tt.entity)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FindKeyringEntry() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("FindKeyringEntry() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
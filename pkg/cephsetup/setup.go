@@ -3,7 +3,7 @@ package cephsetup
 import (
 	"embed"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,7 +13,17 @@ import (
 //go:embed templates/*.tmpl
 var templates embed.FS
 
-func Setup(outputDir string, hosts []string, key string) error {
+// DefaultEntity는 entity를 지정하지 않았을 때 ceph가 쓰는 사용자이다.
+const DefaultEntity = "client.admin"
+
+// KeyringName은 ceph가 entity의 keyring을 찾는 기본 파일 이름이다. 예: ceph.client.admin.keyring
+func KeyringName(entity string) string {
+	return "ceph." + entity + ".keyring"
+}
+
+// Setup은 outputDir에 ceph.conf와 entity의 keyring을 만든다.
+// monHosts의 각 항목은 한 monitor의 주소들이다. 주소가 여럿이면 "[v2:ip:3300,v1:ip:6789]"처럼 묶어서 쓴다.
+func Setup(outputDir string, monHosts [][]string, entity string, key string) error {
 	tmpl, err := template.ParseFS(templates, "templates/*.tmpl")
 	if err != nil {
 		return fmt.Errorf("failed to parse templates: %w", err)
@@ -21,14 +31,14 @@ func Setup(outputDir string, hosts []string, key string) error {
 
 	cephConf := filepath.Join(outputDir, "ceph.conf")
 
-	err = writeTemplate(tmpl, cephConf, "ceph.conf.tmpl", strings.Join(hosts, ","))
+	err = writeTemplate(tmpl, cephConf, "ceph.conf.tmpl", FormatMonHost(monHosts))
 	if err != nil {
 		return fmt.Errorf("failed to write ceph.conf: %w", err)
 	}
 
-	keyringFile := filepath.Join(outputDir, "ceph.client.admin.keyring")
+	keyringFile := filepath.Join(outputDir, KeyringName(entity))
 
-	err = writeTemplate(tmpl, keyringFile, "ceph.client.admin.keyring.tmpl", key)
+	err = writeTemplate(tmpl, keyringFile, "ceph.keyring.tmpl", KeyringEntry{Entity: entity, Key: key})
 	if err != nil {
 		return fmt.Errorf("failed to write keyring: %w", err)
 	}
@@ -36,7 +46,24 @@ func Setup(outputDir string, hosts []string, key string) error {
 	return nil
 }
 
-func writeTemplate(tmpl *template.Template, path string, templateName string, data string) error {
+// FormatMonHost는 monHosts를 mon_host 값으로 만든다. 예: [v2:ip1:3300,v1:ip1:6789],[v2:ip2:3300,v1:ip2:6789]
+// ceph는 앞쪽 monitor부터 접속을 시도한다.
+func FormatMonHost(monHosts [][]string) string {
+	entries := make([]string, 0, len(monHosts))
+
+	for _, addrs := range monHosts {
+		entry := strings.Join(addrs, ",")
+		if len(addrs) > 1 {
+			entry = "[" + entry + "]"
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return strings.Join(entries, ",")
+}
+
+func writeTemplate(tmpl *template.Template, path string, templateName string, data any) error {
 	file, err := os.Create(filepath.Clean(path))
 	if err != nil {
 		return fmt.Errorf("failed to create file: %w", err)
@@ -45,7 +72,7 @@ func writeTemplate(tmpl *template.Template, path string, templateName string, da
 	defer func() {
 		err := file.Close()
 		if err != nil {
-			log.Printf("failed to close file: %v", err)
+			slog.Warn("failed to close file", "path", path, "error", err)
 		}
 	}()
 